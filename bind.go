@@ -0,0 +1,406 @@
+package tinyflags
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/containeroo/tinyflags/internal/builder"
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/dynamic"
+	"github.com/containeroo/tinyflags/internal/engine"
+	"github.com/containeroo/tinyflags/internal/utils"
+)
+
+// Bind registers one flag per exported field of the struct cfg points to, configured from struct tags:
+//
+//	flag:"port" short:"p" env:"PORT" usage:"Listen port" default:"8080" required:"true"
+//	choices:"a,b" oneof:"mode" allornone:"tls" section:"Server" placeholder:"N" hidden:"true" deprecated:"msg"
+//	type:"bytes" (uint64 sizes such as "10MiB") or type:"glob" (string patterns checked with path.Match)
+//
+// Fields without a flag tag use their name in kebab-case, and flag:"-" skips a field. The current field
+// value is the default unless a default tag is set. Nested structs prefix their fields' names ("server-port"),
+// and map[string]Struct fields become dynamic groups whose instances are copied into the map after Parse.
+func Bind(f *FlagSet, cfg any) error {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("tinyflags: Bind requires a non-nil pointer to a struct, got %T", cfg)
+	}
+	return bindStruct(f, v.Elem(), "", bindTags{})
+}
+
+// bindTags holds the struct tags of one field.
+type bindTags struct {
+	name        string
+	kind        string
+	short       string
+	env         string
+	usage       string
+	def         string
+	hasDef      bool
+	required    bool
+	hidden      bool
+	deprecated  string
+	choices     []string
+	oneOf       string
+	allOrNone   string
+	section     string
+	placeholder string
+}
+
+// bindTarget is the flag set or dynamic group a field registers into.
+type bindTarget struct {
+	fs    *FlagSet
+	group *dynamic.Group
+}
+
+// flag returns the metadata of the flag or dynamic field registered under name.
+func (t bindTarget) flag(name string) *core.BaseFlag {
+	if t.group != nil {
+		return t.group.Items()[name].Flag
+	}
+	return t.fs.impl.LookupFlag(name)
+}
+
+// bindStruct registers the fields of v, prefixing their names and inheriting the parent section.
+func bindStruct(f *FlagSet, v reflect.Value, prefix string, parent bindTags) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tags, skip, err := readBindTags(field, prefix, parent)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+
+		fv := v.Field(i)
+		leaf, ok, err := lookupBuiltin(field.Type, tags.kind)
+		if err != nil {
+			return fmt.Errorf("tinyflags: field %s: %w", field.Name, err)
+		}
+		if ok {
+			if err := leaf.bind(bindTarget{fs: f}, fv.Addr().Interface(), tags); err != nil {
+				return fmt.Errorf("tinyflags: field %s: %w", field.Name, err)
+			}
+			continue
+		}
+
+		switch {
+		case field.Type.Kind() == reflect.Struct:
+			nested := tags.name + "-"
+			if field.Anonymous && field.Tag.Get("flag") == "" {
+				nested = prefix
+			}
+			if err := bindStruct(f, fv, nested, tags); err != nil {
+				return err
+			}
+		case field.Type.Kind() == reflect.Map && field.Type.Key().Kind() == reflect.String && field.Type.Elem().Kind() == reflect.Struct:
+			if err := bindGroup(f, fv, tags); err != nil {
+				return fmt.Errorf("tinyflags: field %s: %w", field.Name, err)
+			}
+		default:
+			return fmt.Errorf("tinyflags: field %s: unsupported type %s", field.Name, field.Type)
+		}
+	}
+	return nil
+}
+
+// bindGroup registers a dynamic group for a map[string]Struct field and fills the map after each parse.
+func bindGroup(f *FlagSet, m reflect.Value, tags bindTags) error {
+	group := f.DynamicGroup(tags.name)
+	if tags.hidden {
+		group.Hidden()
+	}
+	if tags.usage != "" {
+		group.Description(tags.usage)
+	}
+
+	elemType := m.Type().Elem()
+	defaults := reflect.New(elemType).Elem()
+	for i := range elemType.NumField() {
+		field := elemType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldTags, skip, err := readBindTags(field, "", bindTags{})
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+		leaf, ok, err := lookupBuiltin(field.Type, fieldTags.kind)
+		if err != nil {
+			return fmt.Errorf("dynamic field %s: %w", field.Name, err)
+		}
+		if !ok {
+			return fmt.Errorf("unsupported type %s for dynamic field %s", field.Type, field.Name)
+		}
+		if fieldTags.short != "" {
+			return fmt.Errorf("dynamic field %s: short tag is not supported on dynamic fields", field.Name)
+		}
+		if err := leaf.bind(bindTarget{fs: f, group: group}, defaults.Field(i).Addr().Interface(), fieldTags); err != nil {
+			return fmt.Errorf("dynamic field %s: %w", field.Name, err)
+		}
+	}
+
+	f.impl.OnParsed(func() error {
+		out := reflect.MakeMap(m.Type())
+		for _, id := range group.Instances() {
+			elem := reflect.New(elemType).Elem()
+			if err := dynamic.DecodeInto(group, id, elem); err != nil {
+				return err
+			}
+			out.SetMapIndex(reflect.ValueOf(id), elem)
+		}
+		m.Set(out)
+		return nil
+	})
+	return nil
+}
+
+// readBindTags parses the tags of field; skip reports flag:"-".
+func readBindTags(field reflect.StructField, prefix string, parent bindTags) (bindTags, bool, error) {
+	tag := field.Tag
+	name := tag.Get("flag")
+	if name == "-" {
+		return bindTags{}, true, nil
+	}
+	if name == "" {
+		name = utils.KebabCase(field.Name)
+	}
+
+	tags := bindTags{
+		name:        prefix + name,
+		kind:        tag.Get("type"),
+		short:       tag.Get("short"),
+		env:         tag.Get("env"),
+		usage:       tag.Get("usage"),
+		deprecated:  tag.Get("deprecated"),
+		oneOf:       tag.Get("oneof"),
+		allOrNone:   tag.Get("allornone"),
+		section:     cmp.Or(tag.Get("section"), parent.section),
+		placeholder: tag.Get("placeholder"),
+	}
+	tags.def, tags.hasDef = tag.Lookup("default")
+	if choices := tag.Get("choices"); choices != "" {
+		tags.choices = strings.Split(choices, ",")
+	}
+
+	var err error
+	if tags.required, err = boolTag(tag, "required"); err != nil {
+		return tags, false, fmt.Errorf("tinyflags: field %s: %w", field.Name, err)
+	}
+	if tags.hidden, err = boolTag(tag, "hidden"); err != nil {
+		return tags, false, fmt.Errorf("tinyflags: field %s: %w", field.Name, err)
+	}
+	return tags, false, nil
+}
+
+// boolTag parses an optional boolean tag.
+func boolTag(tag reflect.StructTag, key string) (bool, error) {
+	raw, ok := tag.Lookup(key)
+	if !ok {
+		return false, nil
+	}
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s tag %q", key, raw)
+	}
+	return v, nil
+}
+
+// bindStringMap registers a map[string]string field; a default tag holds comma-separated key=value entries.
+func bindStringMap(t bindTarget, ptr *map[string]string, tags bindTags) error {
+	def := *ptr
+	if tags.hasDef {
+		def = make(map[string]string)
+		if tags.def != "" {
+			for raw := range strings.SplitSeq(tags.def, ",") {
+				key, val, ok := strings.Cut(raw, "=")
+				if !ok {
+					return fmt.Errorf("invalid default %q: expected key=value entries", tags.def)
+				}
+				def[strings.TrimSpace(key)] = strings.TrimSpace(val)
+			}
+		}
+	}
+	if len(tags.choices) > 0 {
+		return fmt.Errorf("choices are not supported for map fields")
+	}
+
+	if t.group != nil {
+		applyDynamicTags(t.group.StringMap(tags.name, def, tags.usage).DynamicFlag, tags)
+		return nil
+	}
+	fl := t.fs.StringMapVar(ptr, tags.name, def, tags.usage)
+	applyStaticTags(&fl.StaticFlag, tags)
+	return nil
+}
+
+// bindBool registers a bool field.
+func bindBool(t bindTarget, ptr *bool, tags bindTags) error {
+	def := *ptr
+	if tags.hasDef {
+		v, err := strconv.ParseBool(tags.def)
+		if err != nil {
+			return fmt.Errorf("invalid default %q: %w", tags.def, err)
+		}
+		def = v
+	}
+	if t.group != nil {
+		applyDynamicTags(t.group.Bool(tags.name, def, tags.usage).DynamicFlag, tags)
+		return nil
+	}
+	fl := t.fs.BoolVar(ptr, tags.name, def, tags.usage)
+	applyStaticTags(&fl.StaticFlag, tags)
+	return nil
+}
+
+// bindScalar registers a scalar field using the hooks from builtinTypes.
+func bindScalar[T any](t bindTarget, ptr *T, tags bindTags, hooks hookFunc[T]) error {
+	parse, format := hooks(t.fs, tags.name)
+	def := *ptr
+	if tags.hasDef {
+		v, err := parse(tags.def)
+		if err != nil {
+			return fmt.Errorf("invalid default %q: %w", tags.def, err)
+		}
+		def = v
+	}
+	choices, err := parseChoices(parse, tags.choices)
+	if err != nil {
+		return err
+	}
+
+	if t.group != nil {
+		fl := dynamic.Scalar(t.group, tags.name, def, tags.usage, parse, format)
+		if len(choices) > 0 {
+			fl.Choices(choices...)
+		}
+		applyDynamicTags(fl.DynamicFlag, tags)
+		return nil
+	}
+	fl := engine.RegisterStaticScalar(t.fs.impl, ptr, tags.name, tags.usage, def, parse, format)
+	if len(choices) > 0 {
+		fl.Choices(choices...)
+	}
+	applyStaticTags(&fl.StaticFlag, tags)
+	return nil
+}
+
+// bindSlice registers a slice field using the element hooks from builtinTypes.
+func bindSlice[T any](t bindTarget, ptr *[]T, tags bindTags, hooks hookFunc[T]) error {
+	parse, format := hooks(t.fs, tags.name)
+	def := *ptr
+	if tags.hasDef {
+		def = nil
+		if tags.def != "" {
+			for raw := range strings.SplitSeq(tags.def, ",") {
+				v, err := parse(strings.TrimSpace(raw))
+				if err != nil {
+					return fmt.Errorf("invalid default %q: %w", tags.def, err)
+				}
+				def = append(def, v)
+			}
+		}
+	}
+	choices, err := parseChoices(parse, tags.choices)
+	if err != nil {
+		return err
+	}
+
+	if t.group != nil {
+		fl := dynamic.Slice(t.group, tags.name, def, tags.usage, parse, format)
+		if len(choices) > 0 {
+			fl.Choices(choices...)
+		}
+		applyDynamicTags(fl.DynamicFlag, tags)
+		return nil
+	}
+	fl := engine.RegisterStaticSlice(t.fs.impl, ptr, tags.name, tags.usage, def, parse, format, t.fs.impl.DefaultDelimiter(), true)
+	if len(choices) > 0 {
+		fl.Choices(choices...)
+	}
+	applyStaticTags(&fl.StaticFlag, tags)
+	return nil
+}
+
+// parseChoices parses the values of a choices tag.
+func parseChoices[T any](parse ParseFunc[T], raw []string) ([]T, error) {
+	choices := make([]T, 0, len(raw))
+	for _, r := range raw {
+		v, err := parse(strings.TrimSpace(r))
+		if err != nil {
+			return nil, fmt.Errorf("invalid choice %q: %w", r, err)
+		}
+		choices = append(choices, v)
+	}
+	return choices, nil
+}
+
+// applyStaticTags applies the metadata tags through the static flag builder.
+func applyStaticTags[T, Self any](b *builder.StaticFlag[T, Self], tags bindTags) {
+	if tags.short != "" {
+		b.Short(tags.short)
+	}
+	if tags.env != "" {
+		b.Env(tags.env)
+	}
+	if tags.required {
+		b.Required()
+	}
+	if tags.hidden {
+		b.Hidden()
+	}
+	if tags.deprecated != "" {
+		b.Deprecated(tags.deprecated)
+	}
+	if tags.oneOf != "" {
+		b.OneOfGroup(tags.oneOf)
+	}
+	if tags.allOrNone != "" {
+		b.AllOrNone(tags.allOrNone)
+	}
+	if tags.section != "" {
+		b.Section(tags.section)
+	}
+	if tags.placeholder != "" {
+		b.Placeholder(tags.placeholder)
+	}
+}
+
+// applyDynamicTags applies the metadata tags supported by dynamic fields.
+func applyDynamicTags[T any](d *builder.DynamicFlag[T], tags bindTags) {
+	if tags.env != "" {
+		d.Env(tags.env)
+	}
+	if tags.required {
+		d.Required()
+	}
+	if tags.hidden {
+		d.Hidden()
+	}
+	if tags.deprecated != "" {
+		d.Deprecated(tags.deprecated)
+	}
+	if tags.oneOf != "" {
+		d.OneOfGroup(tags.oneOf)
+	}
+	if tags.allOrNone != "" {
+		d.AllOrNone(tags.allOrNone)
+	}
+	if tags.section != "" {
+		d.Section(tags.section)
+	}
+	if tags.placeholder != "" {
+		d.Placeholder(tags.placeholder)
+	}
+}
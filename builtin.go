@@ -0,0 +1,171 @@
+package tinyflags
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/utils"
+)
+
+// hookFunc returns the parse and format functions for T on a flag set; name is the flag or argument name.
+type hookFunc[T any] func(f *FlagSet, name string) (ParseFunc[T], FormatFunc[T])
+
+// builtinType describes how Bind and positional arguments handle one Go type.
+type builtinType struct {
+	hooks func(f *FlagSet, name string) (parse, format any) // nil for types positionals cannot take
+	bind  func(t bindTarget, ptr any, tags bindTags) error
+}
+
+// builtinTypes is the single list of types Bind and positional arguments support.
+// Every element type is also registered as a slice, except bool.
+var builtinTypes = func() map[reflect.Type]builtinType {
+	m := make(map[reflect.Type]builtinType)
+	addBuiltin(m, fixedHooks(utils.ParseString, utils.FormatString))
+	addBuiltin(m, fixedHooks(strconv.Atoi, strconv.Itoa))
+	addBuiltin(m, fixedHooks(parseInt32, formatInt32))
+	addBuiltin(m, fixedHooks(parseInt64, formatInt64))
+	addBuiltin(m, fixedHooks(utils.ParseUnsigned[uint], utils.FormatUnsigned[uint]))
+	addBuiltin(m, fixedHooks(utils.ParseUnsigned[uint8], utils.FormatUnsigned[uint8]))
+	addBuiltin(m, fixedHooks(utils.ParseUnsigned[uint16], utils.FormatUnsigned[uint16]))
+	addBuiltin(m, fixedHooks(utils.ParseUnsigned[uint32], utils.FormatUnsigned[uint32]))
+	addBuiltin(m, fixedHooks(utils.ParseUnsigned[uint64], utils.FormatUnsigned[uint64]))
+	addBuiltin(m, fixedHooks(utils.ParseFloat32, utils.FormatFloat32))
+	addBuiltin(m, fixedHooks(utils.ParseFloat64, utils.FormatFloat64))
+	addBuiltin(m, fixedHooks(time.ParseDuration, time.Duration.String))
+	addBuiltin(m, timeHooks)
+	addBuiltin(m, timeRangeHooks)
+	addBuiltin(m, fixedHooks(utils.ParseLocation, utils.FormatLocation))
+	addBuiltin(m, fixedHooks(utils.ParseIP, utils.FormatIP))
+	addBuiltin(m, fixedHooks(utils.ParseIPv4Mask, utils.FormatIPv4Mask))
+	addBuiltin(m, fixedHooks(utils.ParseTCPAddr, utils.FormatTCPAddr))
+	addBuiltin(m, fixedHooks(utils.ParseAddr, utils.FormatAddr))
+	addBuiltin(m, fixedHooks(utils.ParseAddrPort, utils.FormatAddrPort))
+	addBuiltin(m, fixedHooks(utils.ParsePrefix, utils.FormatPrefix))
+	addBuiltin(m, fixedHooks(utils.ParseIPNet, utils.FormatIPNet))
+	addBuiltin(m, fixedHooks(utils.ParseRegexp, utils.FormatRegexp))
+	addBuiltin(m, templateHooks)
+	addBuiltin(m, fixedHooks(url.Parse, formatURL))
+	addBuiltin(m, fixedHooks(utils.ParseFile, utils.FormatFile))
+
+	m[reflect.TypeFor[bool]()] = builtinType{
+		hooks: eraseHooks(fixedHooks(strconv.ParseBool, strconv.FormatBool)),
+		bind:  func(t bindTarget, ptr any, tags bindTags) error { return bindBool(t, ptr.(*bool), tags) },
+	}
+	m[reflect.TypeFor[map[string]string]()] = builtinType{
+		bind: func(t bindTarget, ptr any, tags bindTags) error {
+			return bindStringMap(t, ptr.(*map[string]string), tags)
+		},
+	}
+	return m
+}()
+
+// taggedTypes holds the variants a type tag selects for fields whose Go type has another default parser.
+var taggedTypes = func() map[string]map[reflect.Type]builtinType {
+	bytes := make(map[reflect.Type]builtinType)
+	addBuiltin(bytes, bytesHooks)
+	withFormat(bytes, core.FormatBytes)
+	glob := make(map[reflect.Type]builtinType)
+	addBuiltin(glob, fixedHooks(utils.ParseGlob, utils.FormatString))
+	return map[string]map[reflect.Type]builtinType{"bytes": bytes, "glob": glob}
+}()
+
+// lookupBuiltin returns the handling for a field of type t, honoring a type tag.
+// ok is false when t is not a built-in type and no type tag was given.
+func lookupBuiltin(t reflect.Type, kind string) (bt builtinType, ok bool, err error) {
+	if kind == "" {
+		bt, ok = builtinTypes[t]
+		return bt, ok, nil
+	}
+	variants, known := taggedTypes[kind]
+	if !known {
+		return bt, false, fmt.Errorf("unknown type tag %q", kind)
+	}
+	if bt, ok = variants[t]; !ok {
+		return bt, false, fmt.Errorf("type tag %q does not apply to %s", kind, t)
+	}
+	return bt, true, nil
+}
+
+// addBuiltin registers T and []T with the given hooks.
+func addBuiltin[T any](m map[reflect.Type]builtinType, hooks hookFunc[T]) {
+	m[reflect.TypeFor[T]()] = builtinType{
+		hooks: eraseHooks(hooks),
+		bind:  func(t bindTarget, ptr any, tags bindTags) error { return bindScalar(t, ptr.(*T), tags, hooks) },
+	}
+	m[reflect.TypeFor[[]T]()] = builtinType{
+		bind: func(t bindTarget, ptr any, tags bindTags) error { return bindSlice(t, ptr.(*[]T), tags, hooks) },
+	}
+}
+
+// withFormat records format on every flag the entries of m register.
+func withFormat(m map[reflect.Type]builtinType, format string) {
+	for typ, bt := range m {
+		bind := bt.bind
+		bt.bind = func(t bindTarget, ptr any, tags bindTags) error {
+			if err := bind(t, ptr, tags); err != nil {
+				return err
+			}
+			t.flag(tags.name).Format = format
+			return nil
+		}
+		m[typ] = bt
+	}
+}
+
+// eraseHooks drops the type parameter so hooks can live in builtinTypes.
+func eraseHooks[T any](hooks hookFunc[T]) func(*FlagSet, string) (any, any) {
+	return func(f *FlagSet, name string) (any, any) {
+		parse, format := hooks(f, name)
+		return parse, format
+	}
+}
+
+// fixedHooks returns hooks that do not depend on the flag set.
+func fixedHooks[T any](parse ParseFunc[T], format FormatFunc[T]) hookFunc[T] {
+	return func(*FlagSet, string) (ParseFunc[T], FormatFunc[T]) { return parse, format }
+}
+
+// timeHooks parse and format with the flag set's time configuration.
+func timeHooks(f *FlagSet, _ string) (ParseFunc[time.Time], FormatFunc[time.Time]) {
+	tc := f.impl.TimeConfig()
+	return tc.ParseTime, tc.FormatTime
+}
+
+// timeRangeHooks parse and format ranges with the flag set's time configuration.
+func timeRangeHooks(f *FlagSet, _ string) (ParseFunc[TimeRange], FormatFunc[TimeRange]) {
+	tc := f.impl.TimeConfig()
+	return tc.ParseTimeRange, tc.FormatTimeRange
+}
+
+// templateHooks name parsed templates after the flag or argument.
+func templateHooks(_ *FlagSet, name string) (ParseFunc[*template.Template], FormatFunc[*template.Template]) {
+	return utils.TemplateFuncs(name)
+}
+
+// bytesHooks parse byte sizes and render them in the flag set's unit system.
+func bytesHooks(f *FlagSet, _ string) (ParseFunc[uint64], FormatFunc[uint64]) {
+	return utils.ParseBytes, func(b uint64) string { return utils.FormatBytesIn(b, f.impl.ByteUnits()) }
+}
+
+func parseInt32(s string) (int32, error) {
+	v, err := strconv.ParseInt(s, 10, 32)
+	return int32(v), err
+}
+
+func formatInt32(v int32) string { return strconv.FormatInt(int64(v), 10) }
+
+func parseInt64(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) }
+
+func formatInt64(v int64) string { return strconv.FormatInt(v, 10) }
+
+func formatURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/engine"
+	"github.com/containeroo/tinyflags/internal/suggest"
 )
 
 // Runnable represents a parsed command that can execute with cancellation support.
@@ -23,15 +25,22 @@ type Command struct {
 	*FlagSet
 
 	name         string
+	aliases      []string
 	summary      string
+	deprecated   string
 	handling     ErrorHandling
 	requireChild bool
+	hidden       bool
 	parent       *Command
 	globals      *FlagSet
 	children     map[string]*Command
 	order        []*Command
 	selected     *Command
 	builder      commandBuilder
+	hooks        commandHooks
+	exec         executeOptions
+	plugins      pluginOptions
+	pluginCall   *pluginCall
 }
 
 type commandBuilder func() (Runnable, error)
@@ -49,7 +58,11 @@ func NewCommand(name string, handling ErrorHandling) *Command {
 }
 
 // Command creates a nested subcommand with the given name and summary.
+// It panics if name is already used by a child command or an alias.
 func (c *Command) Command(name string, summary string) *Command {
+	if _, exists := c.children[name]; exists {
+		panic(fmt.Sprintf("tinyflags: command %q already has a child named %q", c.FullName(), name))
+	}
 	fullName := c.FullName() + " " + name
 	child := &Command{
 		FlagSet:  NewFlagSet(fullName, c.handling),
@@ -60,11 +73,41 @@ func (c *Command) Command(name string, summary string) *Command {
 		globals:  NewFlagSet(fullName, c.handling),
 		children: make(map[string]*Command),
 	}
+	child.impl.InheritSettings(c.impl)
+	child.globals.impl.InheritSettings(child.impl)
 	c.children[name] = child
 	c.order = append(c.order, child)
 	return child
 }
 
+// Aliases registers alternative names that select this command during parsing, e.g. "rm" for "remove".
+func (c *Command) Aliases(names ...string) *Command {
+	if c.parent == nil {
+		panic("tinyflags: aliases require a child command")
+	}
+	for _, name := range names {
+		if _, exists := c.parent.children[name]; exists {
+			panic(fmt.Sprintf("tinyflags: command %q already has a child named %q", c.parent.FullName(), name))
+		}
+		c.parent.children[name] = c
+		c.aliases = append(c.aliases, name)
+	}
+	return c
+}
+
+// Hidden omits the command from help listings, completion and generated docs; it still parses and runs.
+func (c *Command) Hidden() *Command {
+	c.hidden = true
+	return c
+}
+
+// Deprecated marks the command as deprecated; it still runs, but selecting it writes a warning
+// to the output and help and generated docs show the notice.
+func (c *Command) Deprecated(msg string) *Command {
+	c.deprecated = msg
+	return c
+}
+
 // Globals returns the persistent flag set for this command subtree.
 func (c *Command) Globals() *FlagSet {
 	return c.globals
@@ -124,6 +167,21 @@ func (c *Command) Summary() string {
 	return c.summary
 }
 
+// AliasNames returns the alternative names registered with Aliases.
+func (c *Command) AliasNames() []string {
+	return c.aliases
+}
+
+// IsHidden reports whether the command is hidden from help listings.
+func (c *Command) IsHidden() bool {
+	return c.hidden
+}
+
+// DeprecationNotice returns the message set with Deprecated, or "" when the command is current.
+func (c *Command) DeprecationNotice() string {
+	return c.deprecated
+}
+
 // Name returns the command segment name.
 func (c *Command) Name() string {
 	return c.name
@@ -139,68 +197,45 @@ func (c *Command) FullName() string {
 
 // Parse selects a command path and parses matching local and persistent flags.
 func (c *Command) Parse(args []string) error {
+	if c.parent == nil && len(args) > 0 && args[0] == completeCommandName {
+		return c.writeCompletions(context.Background(), args[1:])
+	}
+
 	c.selected = c
+	c.pluginCall = nil
 	state := commandParseState{
 		argsBySet: make(map[*FlagSet][]string),
 	}
-
-	current := c
-	for i := 0; i < len(args); i++ {
-		arg := args[i]
-
-		if arg == "--help" || arg == "-h" {
-			state.helpTarget = current
-			continue
-		}
-		if arg == "--version" {
-			state.versionRequested = true
-			continue
-		}
-
-		if child, ok := current.children[arg]; ok && !strings.HasPrefix(arg, "-") {
-			// Bare child names advance the command cursor instead of becoming positionals.
-			current = child
-			continue
-		}
-
-		if strings.HasPrefix(arg, "--") && arg != "--" {
-			owner, flag := current.resolveLongArg(arg)
-			if owner == nil || flag == nil {
-				state.append(ownerOrCurrent(owner, current), arg)
-				continue
-			}
-
-			state.append(owner, arg)
-			// Route the following token with the same owner when the flag consumes a value.
-			if !strings.Contains(arg, "=") && flagConsumesValue(flag) && i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
-				i++
-				state.append(owner, args[i])
-			}
-			continue
-		}
-
-		if strings.HasPrefix(arg, "-") && arg != "-" {
-			consumed := current.routeShortArgs(arg, args, &i, &state)
-			if consumed {
-				continue
-			}
-		}
-
-		state.append(current.FlagSet, arg)
-	}
+	current := c.route(args, &state)
 
 	c.selected = current
+	c.pluginCall = state.plugin
 	if state.versionRequested {
 		return c.FlagSet.Parse([]string{"--version"})
 	}
 	if state.helpTarget != nil {
 		return RequestHelp(renderCommandHelp(state.helpTarget))
 	}
+	if state.unknown != nil {
+		return &UsageError{Err: state.unknown, Help: renderCommandHelp(state.unknownAt)}
+	}
+	for _, cmd := range c.commandPathTo(current) {
+		if cmd.deprecated != "" {
+			fmt.Fprintf(c.stderr(), "Warning: command %q is deprecated: %s\n", cmd.FullName(), cmd.deprecated) // nolint:errcheck
+		}
+	}
 
 	var errs []error
+	var config commandConfig
 	for _, cmd := range c.commandPathTo(current) {
+		available := cmd.availableFlagSets()
 		for _, fs := range cmd.parseScopes() {
-			if err := fs.Parse(state.argsBySet[fs]); err != nil {
+			fs.impl.SetConfigSection(config.section(cmd))
+			fs.impl.SetSuggestionDistance(c.impl.SuggestionDistance())
+			fs.impl.SuggestFrom(flagSetImpls(available)...)
+			err := fs.Parse(state.argsBySet[fs])
+			config.capture(cmd, fs)
+			if err != nil {
 				errs = append(errs, err)
 				if c.handling != ContinueOnError {
 					return err
@@ -208,7 +243,7 @@ func (c *Command) Parse(args []string) error {
 			}
 		}
 	}
-	if err := c.missingRequiredCommand(current); err != nil {
+	if err := c.missingRequiredCommand(current); err != nil && state.plugin == nil {
 		errs = append(errs, err)
 		if c.handling != ContinueOnError {
 			return err
@@ -226,12 +261,17 @@ func (c *Command) ParseRunnable(args []string) (Runnable, error) {
 	return c.ParseRunner(args)
 }
 
-// ParseRunner parses args and builds the runner for the selected command.
+// ParseRunner parses args and builds the runner for the selected command,
+// wrapped in the hooks and middleware registered along its path.
 func (c *Command) ParseRunner(args []string) (Runner, error) {
 	if err := c.Parse(args); err != nil {
 		return nil, err
 	}
 
+	if c.pluginCall != nil {
+		return pluginRunner{call: *c.pluginCall, cmd: c}, nil
+	}
+
 	selected := c.SelectedCommand()
 	if selected == nil {
 		return nil, fmt.Errorf("tinyflags: no command selected")
@@ -240,13 +280,98 @@ func (c *Command) ParseRunner(args []string) (Runner, error) {
 		return nil, fmt.Errorf("tinyflags: no command runner registered for command %q", selected.FullName())
 	}
 
-	return selected.builder()
+	runner, err := selected.builder()
+	if err != nil {
+		return nil, err
+	}
+	return c.wrapRunner(selected, runner), nil
 }
 
 type commandParseState struct {
 	argsBySet        map[*FlagSet][]string
 	helpTarget       *Command
 	versionRequested bool
+	awaiting         *core.BaseFlag       // Trailing flag still waiting for its value.
+	positionals      []string             // Bare arguments routed to the current command.
+	terminated       bool                 // Whether "--" was seen.
+	unknown          *UnknownCommandError // First bare argument that looks like a mistyped command.
+	unknownAt        *Command             // Command the unknown argument was given to.
+	plugin           *pluginCall          // External plugin selected by the first bare argument.
+}
+
+// route walks args, advancing the command cursor and assigning each token to its owning flag set.
+func (c *Command) route(args []string, state *commandParseState) *Command {
+	current := c
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		state.awaiting = nil
+		if arg == "--" {
+			state.terminated = true
+		}
+
+		if arg == "--help" || arg == "-h" {
+			state.helpTarget = current
+			continue
+		}
+		if arg == "--version" {
+			state.versionRequested = true
+			continue
+		}
+
+		if child, ok := current.children[arg]; ok && !strings.HasPrefix(arg, "-") {
+			// Bare child names advance the command cursor instead of becoming positionals.
+			current = child
+			state.positionals = nil
+			continue
+		}
+
+		if strings.HasPrefix(arg, "--") && arg != "--" {
+			owner, flag := current.resolveLongArg(arg)
+			if owner == nil || flag == nil {
+				state.append(ownerOrCurrent(owner, current), arg)
+				continue
+			}
+
+			state.append(owner, arg)
+			// Route the following token with the same owner when the flag consumes a value.
+			if !strings.Contains(arg, "=") && flagConsumesValue(flag) {
+				if i+1 == len(args) {
+					state.awaiting = flag
+				} else if !strings.HasPrefix(args[i+1], "-") {
+					i++
+					state.append(owner, args[i])
+				}
+			}
+			continue
+		}
+
+		if strings.HasPrefix(arg, "-") && arg != "-" {
+			consumed := current.routeShortArgs(arg, args, &i, state)
+			if consumed {
+				continue
+			}
+		}
+
+		if current == c && !state.terminated && len(state.positionals) == 0 && !strings.HasPrefix(arg, "-") {
+			if plugin, ok := c.lookupPlugin(arg); ok {
+				// Everything after the plugin name belongs to the plugin.
+				state.plugin = &pluginCall{plugin: plugin, args: args[i+1:]}
+				break
+			}
+		}
+
+		if state.unknown == nil && !state.terminated && len(state.positionals) == 0 && !strings.HasPrefix(arg, "-") {
+			if unknown := current.unknownCommand(arg, c.impl.SuggestionDistance()); unknown != nil {
+				state.unknown, state.unknownAt = unknown, current
+			}
+		}
+
+		state.append(current.FlagSet, arg)
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			state.positionals = append(state.positionals, arg)
+		}
+	}
+	return current
 }
 
 // setBuilder stores the internal runner builder shared by all registration styles.
@@ -300,6 +425,35 @@ func (c *Command) missingRequiredCommand(selected *Command) error {
 	return nil
 }
 
+// unknownCommand reports arg as an unknown child command when it is close to a visible child name.
+// It returns nil for plain positional arguments and on commands that declare positionals.
+func (c *Command) unknownCommand(arg string, distance int) *UnknownCommandError {
+	visible := c.visibleCommands()
+	if len(visible) == 0 || c.impl.TakesPositionals() {
+		return nil
+	}
+	var candidates []suggest.Candidate
+	for _, child := range visible {
+		for _, name := range append([]string{child.name}, child.aliases...) {
+			candidates = append(candidates, suggest.Candidate{Name: name, Display: name})
+		}
+	}
+	suggestions := suggest.Closest(arg, candidates, distance)
+	if len(suggestions) == 0 {
+		return nil
+	}
+	return &UnknownCommandError{Command: c.FullName(), Name: arg, Suggestions: suggestions}
+}
+
+// flagSetImpls returns the engine flag sets behind sets.
+func flagSetImpls(sets []*FlagSet) []*engine.FlagSet {
+	impls := make([]*engine.FlagSet, len(sets))
+	for i, fs := range sets {
+		impls[i] = fs.impl
+	}
+	return impls
+}
+
 // availableFlagSets returns local and inherited persistent flag sets in lookup order.
 func (c *Command) availableFlagSets() []*FlagSet {
 	scopes := []*FlagSet{c.FlagSet}
@@ -368,7 +522,9 @@ func (c *Command) routeShortArgs(arg string, args []string, i *int, state *comma
 				return true
 			}
 			state.append(owner, "-"+short)
-			if *i+1 < len(args) && !strings.HasPrefix(args[*i+1], "-") {
+			if *i+1 == len(args) {
+				state.awaiting = fl
+			} else if !strings.HasPrefix(args[*i+1], "-") {
 				*i++
 				state.append(owner, args[*i])
 			}
@@ -424,17 +580,38 @@ func renderCommandHelp(cmd *Command) string {
 
 	var b strings.Builder
 	b.WriteString(strings.Join(lines, "\n"))
-	if len(cmd.order) > 0 {
+	if visible := cmd.visibleCommands(); len(visible) > 0 {
 		b.WriteString("\n\nCommands:\n")
-		width := longestCommandName(cmd.order)
-		for _, child := range cmd.order {
-			fmt.Fprintf(&b, "  %-*s  %s\n", width, child.name, child.summary)
+		width := longestCommandName(visible)
+		for _, child := range visible {
+			summary := child.summary
+			if child.deprecated != "" {
+				summary += " (deprecated: " + child.deprecated + ")"
+			}
+			fmt.Fprintf(&b, "  %-*s  %s\n", width, child.listName(), summary)
+		}
+	}
+	if plugins := cmd.Plugins(); len(plugins) > 0 {
+		b.WriteString("\nPlugins:\n")
+		for _, plugin := range plugins {
+			fmt.Fprintf(&b, "  %s\n", plugin.Name)
 		}
 	}
 	b.WriteString("\n")
 	return b.String()
 }
 
+// visibleCommands returns child commands that appear in help and completion output.
+func (c *Command) visibleCommands() []*Command {
+	visible := make([]*Command, 0, len(c.order))
+	for _, child := range c.order {
+		if !child.hidden {
+			visible = append(visible, child)
+		}
+	}
+	return visible
+}
+
 // renderLocalHelp renders help text for one command-local flag set.
 func renderLocalHelp(fs *FlagSet) string {
 	if fs == nil || fs.impl == nil {
@@ -449,6 +626,7 @@ func renderUsageLine(cmd *Command) string {
 	if hasAnyVisibleFlags(cmd.FlagSet) {
 		usage += " [flags]"
 	}
+	usage += cmd.impl.PositionalUsage()
 	if len(cmd.order) > 0 {
 		usage += " <command>"
 	}
@@ -479,9 +657,12 @@ func hasAnyVisibleFlags(fs *FlagSet) bool {
 func longestCommandName(commands []*Command) int {
 	width := 0
 	for _, cmd := range commands {
-		if len(cmd.name) > width {
-			width = len(cmd.name)
-		}
+		width = max(width, len(cmd.listName()))
 	}
 	return width
 }
+
+// listName returns the command name followed by its aliases, as shown in command listings.
+func (c *Command) listName() string {
+	return strings.Join(append([]string{c.name}, c.aliases...), ", ")
+}
@@ -0,0 +1,229 @@
+package tinyflags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// Exit codes returned by Command.Execute.
+const (
+	ExitOK    = 0 // Command ran successfully, or help/version was printed
+	ExitFail  = 1 // Handler returned an error without an ExitCoder
+	ExitUsage = 2 // Parsing failed (same code as ExitOnError)
+)
+
+// ExitCoder is implemented by errors that choose the process exit code returned by Command.Execute.
+type ExitCoder interface {
+	ExitCode() int
+}
+
+// ExitError attaches an exit code to an error.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// Error returns the wrapped error message.
+func (e *ExitError) Error() string {
+	if e == nil || e.Err == nil {
+		return ""
+	}
+	return e.Err.Error()
+}
+
+// Unwrap exposes the wrapped error.
+func (e *ExitError) Unwrap() error { return e.Err }
+
+// ExitCode returns the attached exit code.
+func (e *ExitError) ExitCode() int { return e.Code }
+
+// WithExitCode wraps err so Command.Execute exits with code; a nil err stays nil.
+func WithExitCode(err error, code int) error {
+	if err == nil {
+		return nil
+	}
+	return &ExitError{Code: code, Err: err}
+}
+
+type executeOptions struct {
+	stdout     io.Writer
+	stderr     io.Writer
+	signals    []os.Signal
+	signalsSet bool
+	exit       func(int)
+}
+
+// SetStdout sets where Execute writes help and version output (default: os.Stdout).
+func (c *Command) SetStdout(w io.Writer) *Command {
+	c.exec.stdout = w
+	return c
+}
+
+// SetStderr sets where Execute writes errors and Parse writes deprecation warnings (default: os.Stderr).
+func (c *Command) SetStderr(w io.Writer) *Command {
+	c.exec.stderr = w
+	return c
+}
+
+// NotifySignals sets the signals that cancel the context passed to the handler during Execute
+// (default: os.Interrupt and SIGTERM); calling it without signals disables signal handling.
+func (c *Command) NotifySignals(sigs ...os.Signal) *Command {
+	c.exec.signals = sigs
+	c.exec.signalsSet = true
+	return c
+}
+
+// SetExitFunc replaces os.Exit for ExecuteAndExit and the forced exit on a second signal.
+func (c *Command) SetExitFunc(fn func(int)) *Command {
+	c.exec.exit = fn
+	return c
+}
+
+// Execute parses args, runs the selected command and returns the process exit code.
+// Help and version go to stdout with code 0; parse errors go to stderr with code 2, plus the
+// command help when available. During the run the first notified signal cancels ctx and a second
+// one exits immediately. Handler errors exit with their ExitCoder code, 128+signal when the
+// handler stopped with the canceled context, or 1 otherwise.
+func (c *Command) Execute(ctx context.Context, args []string) int {
+	stdout := c.exec.stdout
+	if stdout == nil {
+		stdout = os.Stdout
+	}
+	stderr := c.stderr()
+
+	runner, err := c.ParseRunner(args)
+	if err != nil {
+		return c.reportParseError(stdout, stderr, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	received := c.watchSignals(cancel)
+	defer received.stop()
+
+	err = runner.Run(ctx)
+	if err == nil {
+		return ExitOK
+	}
+
+	var coder ExitCoder
+	switch {
+	case errors.As(err, &coder):
+		if msg := err.Error(); msg != "" {
+			fmt.Fprintf(stderr, "Error: %s\n", msg) // nolint:errcheck
+		}
+		return coder.ExitCode()
+	case errors.Is(err, context.Canceled) && received.code() != 0:
+		return received.code()
+	default:
+		fmt.Fprintf(stderr, "Error: %v\n", err) // nolint:errcheck
+		return ExitFail
+	}
+}
+
+// ExecuteAndExit calls Execute with os.Args[1:] and exits the process with its code.
+func (c *Command) ExecuteAndExit(ctx context.Context) {
+	c.exitFunc()(c.Execute(ctx, os.Args[1:]))
+}
+
+// reportParseError writes the outcome of a failed parse and returns its exit code.
+func (c *Command) reportParseError(stdout, stderr io.Writer, err error) int {
+	switch {
+	case IsCompletionRequested(err):
+		return ExitOK
+	case IsHelpRequested(err):
+		fmt.Fprint(stdout, err) // nolint:errcheck
+		return ExitOK
+	case IsVersionRequested(err):
+		fmt.Fprintln(stdout, err) // nolint:errcheck
+		return ExitOK
+	}
+
+	fmt.Fprintf(stderr, "Error: %v\n", err) // nolint:errcheck
+	if help, ok := HelpText(err); ok {
+		fmt.Fprintf(stderr, "\n%s", help) // nolint:errcheck
+	}
+	return ExitUsage
+}
+
+// stderr returns the writer for errors and warnings, defaulting to os.Stderr.
+func (c *Command) stderr() io.Writer {
+	if c.exec.stderr == nil {
+		return os.Stderr
+	}
+	return c.exec.stderr
+}
+
+// exitFunc returns the configured exit function, defaulting to os.Exit.
+func (c *Command) exitFunc() func(int) {
+	if c.exec.exit != nil {
+		return c.exec.exit
+	}
+	return os.Exit
+}
+
+type signalWatcher struct {
+	ch     chan os.Signal
+	done   chan struct{}
+	mu     sync.Mutex
+	signal int // Exit code for the first received signal, 0 when none arrived.
+}
+
+// code returns the exit code for the first received signal, or 0 when none arrived.
+func (w *signalWatcher) code() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.signal
+}
+
+// stop unregisters the signals and ends the watcher goroutine.
+func (w *signalWatcher) stop() {
+	signal.Stop(w.ch)
+	close(w.done)
+}
+
+// watchSignals cancels the run on the first signal and forces an exit on the second.
+func (c *Command) watchSignals(cancel context.CancelFunc) *signalWatcher {
+	sigs := c.exec.signals
+	if !c.exec.signalsSet {
+		sigs = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	}
+	w := &signalWatcher{ch: make(chan os.Signal, 2), done: make(chan struct{})}
+	if len(sigs) == 0 {
+		return w
+	}
+
+	signal.Notify(w.ch, sigs...)
+	exit := c.exitFunc()
+	go func() {
+		select {
+		case first := <-w.ch:
+			w.mu.Lock()
+			w.signal = signalExitCode(first)
+			w.mu.Unlock()
+			cancel()
+		case <-w.done:
+			return
+		}
+		select {
+		case second := <-w.ch:
+			exit(signalExitCode(second))
+		case <-w.done:
+		}
+	}()
+	return w
+}
+
+// signalExitCode returns the conventional 128+n exit code for a signal.
+func signalExitCode(sig os.Signal) int {
+	if s, ok := sig.(syscall.Signal); ok {
+		return 128 + int(s)
+	}
+	return ExitFail
+}
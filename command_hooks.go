@@ -0,0 +1,107 @@
+package tinyflags
+
+import (
+	"context"
+	"errors"
+)
+
+// CommandHook runs around a command handler and receives the selected command.
+type CommandHook func(ctx context.Context, cmd *Command) error
+
+// Middleware wraps the runner built for the selected command.
+type Middleware func(next Runner) Runner
+
+// RunnerFunc adapts a plain function to the Runner interface.
+type RunnerFunc func(context.Context) error
+
+// Run calls fn(ctx).
+func (fn RunnerFunc) Run(ctx context.Context) error { return fn(ctx) }
+
+type commandHooks struct {
+	preRun            []CommandHook
+	postRun           []CommandHook
+	persistentPreRun  []CommandHook
+	persistentPostRun []CommandHook
+	middleware        []Middleware
+}
+
+// PreRun registers a hook that runs before this command's handler when it is the selected command.
+func (c *Command) PreRun(hook CommandHook) *Command {
+	c.hooks.preRun = append(c.hooks.preRun, hook)
+	return c
+}
+
+// PostRun registers a hook that runs after this command's handler when it is the selected command.
+func (c *Command) PostRun(hook CommandHook) *Command {
+	c.hooks.postRun = append(c.hooks.postRun, hook)
+	return c
+}
+
+// PersistentPreRun registers a hook that runs before the handler of this command or any descendant.
+func (c *Command) PersistentPreRun(hook CommandHook) *Command {
+	c.hooks.persistentPreRun = append(c.hooks.persistentPreRun, hook)
+	return c
+}
+
+// PersistentPostRun registers a hook that runs after the handler of this command or any descendant.
+func (c *Command) PersistentPostRun(hook CommandHook) *Command {
+	c.hooks.persistentPostRun = append(c.hooks.persistentPostRun, hook)
+	return c
+}
+
+// Use registers middleware that wraps the runner of this command or any descendant.
+// Middleware of parent commands wraps that of children, and earlier registrations wrap later ones.
+func (c *Command) Use(middleware ...Middleware) *Command {
+	c.hooks.middleware = append(c.hooks.middleware, middleware...)
+	return c
+}
+
+// wrapRunner adds the hooks and middleware along the path to selected around the handler runner.
+func (c *Command) wrapRunner(selected *Command, runner Runner) Runner {
+	path := c.commandPathTo(selected)
+
+	var pre, post []CommandHook
+	for _, cmd := range path {
+		pre = append(pre, cmd.hooks.persistentPreRun...)
+	}
+	pre = append(pre, selected.hooks.preRun...)
+	post = append(post, selected.hooks.postRun...)
+	for i := len(path) - 1; i >= 0; i-- {
+		post = append(post, path[i].hooks.persistentPostRun...)
+	}
+
+	if len(pre) > 0 || len(post) > 0 {
+		runner = hookedRunner{cmd: selected, pre: pre, post: post, next: runner}
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		middleware := path[i].hooks.middleware
+		for j := len(middleware) - 1; j >= 0; j-- {
+			runner = middleware[j](runner)
+		}
+	}
+	return runner
+}
+
+type hookedRunner struct {
+	cmd  *Command
+	pre  []CommandHook
+	post []CommandHook
+	next Runner
+}
+
+// Run executes the pre hooks, the handler and the post hooks.
+// A failing pre hook stops the run; post hooks run even when the handler fails.
+func (r hookedRunner) Run(ctx context.Context) error {
+	for _, hook := range r.pre {
+		if err := hook(ctx, r.cmd); err != nil {
+			return err
+		}
+	}
+
+	errs := []error{r.next.Run(ctx)}
+	for _, hook := range r.post {
+		errs = append(errs, hook(ctx, r.cmd))
+	}
+	return errors.Join(errs...)
+}
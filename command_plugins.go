@@ -0,0 +1,170 @@
+package tinyflags
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Plugin is an external executable that provides a subcommand, e.g. "app-foo" for "app foo".
+type Plugin struct {
+	Name string // Subcommand name (executable name without the "<root>-" prefix)
+	Path string // Resolved executable path
+}
+
+type pluginOptions struct {
+	enabled bool
+	dirs    []string // Searched directories; empty means PATH.
+}
+
+type pluginCall struct {
+	plugin Plugin
+	args   []string
+}
+
+// EnablePlugins makes unknown child names of this root command run external "<root>-<name>" executables
+// found in dirs, or in PATH when no dirs are given.
+func (c *Command) EnablePlugins(dirs ...string) *Command {
+	if c.parent != nil {
+		panic("tinyflags: plugins require the root command")
+	}
+	c.plugins = pluginOptions{enabled: true, dirs: dirs}
+	return c
+}
+
+// DisablePlugins turns plugin lookup off again, e.g. when a --no-plugins flag or env var is set.
+func (c *Command) DisablePlugins() *Command {
+	c.plugins.enabled = false
+	return c
+}
+
+// SelectedPlugin returns the plugin selected during the last parse, or nil when a built-in command was selected.
+func (c *Command) SelectedPlugin() *Plugin {
+	if c.pluginCall == nil {
+		return nil
+	}
+	return &c.pluginCall.plugin
+}
+
+// Plugins returns the plugins found in the search directories, sorted by name.
+// Names that collide with built-in child commands are left out because built-ins win.
+func (c *Command) Plugins() []Plugin {
+	if !c.plugins.enabled {
+		return nil
+	}
+
+	prefix := c.name + "-"
+	var plugins []Plugin
+	for _, dir := range c.pluginDirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := strings.CutPrefix(pluginBaseName(entry.Name()), prefix)
+			if !ok || name == "" || entry.IsDir() || c.children[name] != nil {
+				continue
+			}
+			if slices.ContainsFunc(plugins, func(p Plugin) bool { return p.Name == name }) {
+				continue
+			}
+			if path, err := exec.LookPath(filepath.Join(dir, entry.Name())); err == nil {
+				plugins = append(plugins, Plugin{Name: name, Path: path})
+			}
+		}
+	}
+	slices.SortFunc(plugins, func(a, b Plugin) int { return strings.Compare(a.Name, b.Name) })
+	return plugins
+}
+
+// lookupPlugin resolves the executable for the plugin name. Only roots with child commands
+// or RequireCommand look plugins up, so a root taking plain positionals never runs one.
+func (c *Command) lookupPlugin(name string) (Plugin, bool) {
+	if !c.plugins.enabled || name == "" || strings.ContainsAny(name, `/\`) {
+		return Plugin{}, false
+	}
+	if len(c.children) == 0 && !c.requireChild {
+		return Plugin{}, false
+	}
+
+	file := c.name + "-" + name
+	if len(c.plugins.dirs) == 0 {
+		path, err := exec.LookPath(file)
+		return Plugin{Name: name, Path: path}, err == nil
+	}
+	for _, dir := range c.plugins.dirs {
+		if path, err := exec.LookPath(filepath.Join(dir, file)); err == nil {
+			return Plugin{Name: name, Path: path}, true
+		}
+	}
+	return Plugin{}, false
+}
+
+// pluginDirs returns the configured search directories or the PATH entries.
+func (c *Command) pluginDirs() []string {
+	if len(c.plugins.dirs) > 0 {
+		return c.plugins.dirs
+	}
+	return filepath.SplitList(os.Getenv("PATH"))
+}
+
+// pluginBaseName strips executable extensions on Windows.
+func pluginBaseName(file string) string {
+	if runtime.GOOS == "windows" {
+		return strings.TrimSuffix(file, filepath.Ext(file))
+	}
+	return file
+}
+
+// pluginRunner executes a plugin with the remaining args, inherited env and the Execute streams.
+type pluginRunner struct {
+	call pluginCall
+	cmd  *Command
+}
+
+// pluginWaitDelay is how long a canceled plugin may take to exit after SIGTERM before it is killed.
+const pluginWaitDelay = 5 * time.Second
+
+// Run starts the plugin and waits for it; a non-zero exit status is returned as an ExitCoder
+// without a message, so Execute exits with the plugin's code, or 128+signal when it was killed.
+// Canceling ctx sends SIGTERM and kills the plugin only if it is still running after pluginWaitDelay.
+func (r pluginRunner) Run(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, r.call.plugin.Path, r.call.args...)
+	cmd.Cancel = func() error {
+		if err := cmd.Process.Signal(syscall.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return cmd.Process.Kill()
+		}
+		return nil
+	}
+	cmd.WaitDelay = pluginWaitDelay
+	cmd.Env = os.Environ()
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if r.cmd.exec.stdout != nil {
+		cmd.Stdout = r.cmd.exec.stdout
+	}
+	if r.cmd.exec.stderr != nil {
+		cmd.Stderr = r.cmd.exec.stderr
+	}
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return err
+	}
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return &ExitError{Code: signalExitCode(status.Signal())}
+	}
+	if exitErr.ExitCode() > 0 {
+		return &ExitError{Code: exitErr.ExitCode()}
+	}
+	return err
+}
@@ -0,0 +1,248 @@
+package tinyflags
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/dynamic"
+)
+
+// Runtime completion types.
+type (
+	Completion          = core.Completion          // One completion candidate with optional description
+	CompletionDirective = core.CompletionDirective // Shell hint for handling candidates
+	CompleteFunc        = core.CompleteFunc        // Runtime completer for a flag value or positional argument
+)
+
+const (
+	CompleteDefault    = core.CompleteDefault    // Fall back to file completion when nothing matches
+	CompleteError      = core.CompleteError      // Completion failed; offer nothing
+	CompleteNoSpace    = core.CompleteNoSpace    // Do not append a space after the candidate
+	CompleteNoFile     = core.CompleteNoFile     // Never fall back to file completion
+	CompleteFilterExt  = core.CompleteFilterExt  // Candidates are file extensions to filter by
+	CompleteFilterDirs = core.CompleteFilterDirs // Complete directory names only
+)
+
+// completeCommandName is the hidden argument that switches Command.Parse into completion mode.
+const completeCommandName = "__complete"
+
+// CompleteArgs sets a runtime completer for positional arguments.
+func (f *FlagSet) CompleteArgs(fn CompleteFunc) { f.impl.CompleteArgs(fn) }
+
+// Completions returns candidates for the last element of args, which holds the partial word being completed.
+// The preceding elements are routed exactly like Parse routes them to find the cursor context.
+func (c *Command) Completions(ctx context.Context, args []string) ([]Completion, CompletionDirective) {
+	cur := ""
+	if len(args) > 0 {
+		cur = args[len(args)-1]
+		args = args[:len(args)-1]
+	}
+
+	state := commandParseState{
+		argsBySet: make(map[*FlagSet][]string),
+	}
+	current := c.route(args, &state)
+
+	switch {
+	case strings.HasPrefix(cur, "--") && strings.Contains(cur, "="):
+		name, value, _ := strings.Cut(cur, "=")
+		_, fl := current.resolveLongArg(name)
+		if fl == nil {
+			return nil, CompleteNoFile
+		}
+		comps, directive := completeFlagValue(ctx, fl, value)
+		if directive&CompleteFilterExt == 0 {
+			for i := range comps {
+				comps[i].Value = name + "=" + comps[i].Value
+			}
+		}
+		return comps, directive
+	case state.awaiting != nil && (!strings.HasPrefix(cur, "-") || cur == "-"):
+		return completeFlagValue(ctx, state.awaiting, cur)
+	case strings.HasPrefix(cur, "-"):
+		return current.completeFlagNames(cur, args)
+	default:
+		return current.completeArgs(ctx, cur)
+	}
+}
+
+// writeCompletions answers a __complete request on the command's output.
+// Each candidate is written as "value[\tdescription]", followed by a ":<directive>" line.
+func (c *Command) writeCompletions(ctx context.Context, args []string) error {
+	// Some shells cannot pass an empty argument and send a literal "" instead.
+	if n := len(args); n > 0 && args[n-1] == `""` {
+		args[n-1] = ""
+	}
+
+	comps, directive := c.Completions(ctx, args)
+	var b strings.Builder
+	for _, comp := range comps {
+		b.WriteString(completionLine(comp.Value))
+		if desc := completionLine(comp.Description); desc != "" {
+			b.WriteString("\t" + desc)
+		}
+		b.WriteByte('\n')
+	}
+	fmt.Fprintf(&b, ":%d\n", directive)
+	if _, err := io.WriteString(c.Output(), b.String()); err != nil {
+		return err
+	}
+	return &CompletionRequested{}
+}
+
+// completeFlagNames offers visible flag spellings and dynamic group paths matching cur.
+func (c *Command) completeFlagNames(cur string, words []string) ([]Completion, CompletionDirective) {
+	var comps []Completion
+	directive := CompleteNoFile
+	seen := make(map[string]bool)
+
+	for _, fs := range c.availableFlagSets() {
+		for _, fl := range fs.impl.VisibleStaticFlags() {
+			if seen[fl.Name] {
+				continue
+			}
+			seen[fl.Name] = true
+			names := []string{"--" + fl.Name}
+			if fl.Short != "" {
+				names = append(names, "-"+fl.Short)
+			}
+			for _, name := range names {
+				if strings.HasPrefix(name, cur) {
+					comps = append(comps, Completion{Value: name, Description: fl.Usage})
+				}
+			}
+		}
+		for _, group := range fs.impl.DynamicGroups() {
+			if group.IsHidden() {
+				continue
+			}
+			groupComps, partial := completeDynamicFlagNames(group, cur, words)
+			comps = append(comps, groupComps...)
+			if partial {
+				directive |= CompleteNoSpace
+			}
+		}
+	}
+	return comps, directive
+}
+
+// completeArgs offers child commands and positional candidates for a bare word.
+func (c *Command) completeArgs(ctx context.Context, cur string) ([]Completion, CompletionDirective) {
+	var comps []Completion
+	directive := CompleteDefault
+
+	if children := c.visibleCommands(); len(children) > 0 {
+		directive = CompleteNoFile
+		for _, child := range children {
+			if strings.HasPrefix(child.name, cur) {
+				comps = append(comps, Completion{Value: child.name, Description: child.summary})
+			}
+		}
+	}
+	if fn := c.impl.ArgsCompleter(); fn != nil {
+		directive = CompleteNoFile
+		comps = append(comps, filterCompletions(fn(ctx, cur), cur)...)
+	}
+	return comps, directive
+}
+
+// completeFlagValue offers candidates for one flag's value.
+func completeFlagValue(ctx context.Context, fl *core.BaseFlag, prefix string) ([]Completion, CompletionDirective) {
+	switch {
+	case fl.CompleteHint&CompleteFilterExt != 0:
+		comps := make([]Completion, 0, len(fl.CompleteExts))
+		for _, ext := range fl.CompleteExts {
+			comps = append(comps, Completion{Value: strings.TrimPrefix(ext, ".")})
+		}
+		return comps, fl.CompleteHint
+	case fl.CompleteHint&CompleteFilterDirs != 0:
+		return nil, fl.CompleteHint
+	case fl.Completer != nil:
+		return filterCompletions(fl.Completer(ctx, prefix), prefix), fl.CompleteHint
+	}
+
+	if allowed := fl.AllowedValues(); len(allowed) > 0 {
+		var comps []Completion
+		for _, v := range allowed {
+			if strings.HasPrefix(v, prefix) {
+				comps = append(comps, Completion{Value: v})
+			}
+		}
+		return comps, fl.CompleteHint | CompleteNoFile
+	}
+	return nil, fl.CompleteHint
+}
+
+// completeDynamicFlagNames offers "--group.", "--group.<id>." and "--group.<id>.<field>" spellings.
+// The boolean result reports whether the candidates are partial and must not be followed by a space.
+func completeDynamicFlagNames(group *dynamic.Group, cur string, words []string) ([]Completion, bool) {
+	prefix := "--" + group.Name() + "."
+	if !strings.HasPrefix(cur, prefix) {
+		if strings.HasPrefix(prefix, cur) {
+			return []Completion{{Value: prefix, Description: group.TitleText()}}, true
+		}
+		return nil, false
+	}
+
+	if id, _, ok := strings.Cut(cur[len(prefix):], "."); ok {
+		var comps []Completion
+		for _, fl := range group.DynamicFlags() {
+			name := prefix + id + "." + fl.Name
+			if !fl.Hidden && strings.HasPrefix(name, cur) {
+				comps = append(comps, Completion{Value: name, Description: fl.Usage})
+			}
+		}
+		return comps, false
+	}
+
+	var comps []Completion
+	for _, id := range dynamicIDsInArgs(group.Name(), words) {
+		if name := prefix + id + "."; strings.HasPrefix(name, cur) {
+			comps = append(comps, Completion{Value: name})
+		}
+	}
+	return comps, true
+}
+
+// dynamicIDsInArgs returns the instance IDs already used for a dynamic group, in order of appearance.
+func dynamicIDsInArgs(groupName string, args []string) []string {
+	prefix := "--" + groupName + "."
+	var ids []string
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		rest, ok := strings.CutPrefix(arg, prefix)
+		if !ok {
+			continue
+		}
+		rest, _, _ = strings.Cut(rest, "=")
+		id, _, ok := strings.Cut(rest, ".")
+		if !ok || id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// filterCompletions keeps candidates whose value starts with prefix.
+func filterCompletions(comps []Completion, prefix string) []Completion {
+	out := comps[:0:0]
+	for _, comp := range comps {
+		if strings.HasPrefix(comp.Value, prefix) {
+			out = append(out, comp)
+		}
+	}
+	return out
+}
+
+// completionLine flattens s to one protocol-safe line.
+func completionLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(strings.ReplaceAll(s, "\t", " "))
+}
@@ -0,0 +1,86 @@
+package tinyflags
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/containeroo/tinyflags/internal/completion"
+)
+
+// CompletionShells lists the shells supported by GenCompletion.
+func CompletionShells() []string {
+	return append([]string(nil), completion.Shells...)
+}
+
+// GenCompletion writes a completion script for the command tree containing c.
+// Supported shells are bash, zsh, fish and powershell. Commands, flags and allowed values
+// are embedded in the script; runtime completers, dynamic groups and file filters are
+// resolved by calling the program with the hidden __complete argument.
+func (c *Command) GenCompletion(w io.Writer, shell string) error {
+	if w == nil {
+		return nil
+	}
+	return completion.Generate(w, shell, c.root().completionSpec())
+}
+
+// AddCompletionCommand registers a hidden "completion <shell>" subcommand that prints the script to c's output.
+func (c *Command) AddCompletionCommand() *Command {
+	cmd := c.Command("completion", "Generate shell completion scripts")
+	cmd.Hidden()
+	cmd.RequirePositional(1)
+	cmd.Run(func() error {
+		shell, _ := cmd.Arg(0)
+		if err := c.GenCompletion(c.Output(), shell); err != nil {
+			return fmt.Errorf("completion: %w", err)
+		}
+		return nil
+	})
+	return cmd
+}
+
+// root returns the top-level command of the tree containing c.
+func (c *Command) root() *Command {
+	for c.parent != nil {
+		c = c.parent
+	}
+	return c
+}
+
+// completionSpec converts the command subtree into the shell-neutral completion model.
+func (c *Command) completionSpec() *completion.Command {
+	node := &completion.Command{
+		Name:    c.name,
+		Aliases: c.aliases,
+		Path:    c.FullName(),
+		Summary: c.summary,
+	}
+
+	seen := make(map[string]bool)
+	for _, fs := range c.availableFlagSets() {
+		for _, fl := range fs.impl.VisibleStaticFlags() {
+			if seen[fl.Name] {
+				continue
+			}
+			seen[fl.Name] = true
+			node.Flags = append(node.Flags, completion.Flag{
+				Name:       fl.Name,
+				Short:      fl.Short,
+				Usage:      fl.Usage,
+				TakesValue: flagConsumesValue(fl),
+				Values:     fl.AllowedValues(),
+				Runtime:    fl.Completer != nil || fl.CompleteHint&(CompleteFilterExt|CompleteFilterDirs) != 0,
+			})
+		}
+		for _, group := range fs.impl.DynamicGroups() {
+			if !group.IsHidden() {
+				node.RuntimeFlags = true
+			}
+		}
+	}
+	node.RuntimeArgs = c.impl.ArgsCompleter() != nil
+
+	for _, child := range c.visibleCommands() {
+		node.Commands = append(node.Commands, child.completionSpec())
+	}
+	return node
+}
@@ -0,0 +1,57 @@
+package tinyflags
+
+import (
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/engine"
+)
+
+// ConfigDecoder decodes a config file into nested key/value maps.
+type ConfigDecoder = core.ConfigDecoder
+
+// ConfigLineDecoder is an optional ConfigDecoder extension that reports the line of each key.
+type ConfigLineDecoder = core.ConfigLineDecoder
+
+// ConfigDecoderFunc adapts a plain function to ConfigDecoder.
+type ConfigDecoderFunc = core.ConfigDecoderFunc
+
+// JSONDecoder is the built-in encoding/json config decoder.
+type JSONDecoder = core.JSONDecoder
+
+// ConfigFile loads unset flags from the file at path; a missing file is ignored.
+func (f *FlagSet) ConfigFile(path string) { f.impl.ConfigFile(path) }
+
+// ConfigFileFlag loads unset flags from the file named by the given string flag.
+// An explicitly set path must exist; an unchanged default path may be missing.
+func (f *FlagSet) ConfigFileFlag(name string) { f.impl.ConfigFileFlag(name) }
+
+// SetConfigDecoder replaces the config decoder (default: JSONDecoder).
+func (f *FlagSet) SetConfigDecoder(d ConfigDecoder) { f.impl.SetConfigDecoder(d) }
+
+// commandConfig tracks the config document loaded while parsing a command path.
+type commandConfig struct {
+	doc   *engine.ConfigSection
+	owner *Command
+}
+
+// capture remembers the document loaded by fs, if any.
+func (cc *commandConfig) capture(cmd *Command, fs *FlagSet) {
+	if doc := fs.impl.LoadedConfig(); doc != nil {
+		cc.doc, cc.owner = doc, cmd
+	}
+}
+
+// section returns the part of the document scoped to cmd, nested by command name below the owner.
+func (cc *commandConfig) section(cmd *Command) *engine.ConfigSection {
+	if cc.doc == nil {
+		return nil
+	}
+	var names []string
+	for cur := cmd; cur != nil && cur != cc.owner; cur = cur.parent {
+		names = append([]string{cur.name}, names...)
+	}
+	section := cc.doc
+	for _, name := range names {
+		section = section.Sub(name)
+	}
+	return section
+}
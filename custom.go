@@ -0,0 +1,113 @@
+package tinyflags
+
+import (
+	"fmt"
+
+	"github.com/containeroo/tinyflags/internal/dynamic"
+	"github.com/containeroo/tinyflags/internal/engine"
+	"github.com/containeroo/tinyflags/internal/mapflag"
+	"github.com/containeroo/tinyflags/internal/scalar"
+	"github.com/containeroo/tinyflags/internal/slice"
+)
+
+// ParseFunc converts one raw CLI or env input into a typed value.
+type ParseFunc[T any] = func(string) (T, error)
+
+// FormatFunc renders a typed value for help output and default strings.
+type FormatFunc[T any] = func(T) string
+
+// Var defines a flag of a user-defined type and binds it to the given pointer.
+// parse converts raw input; format renders defaults and allowed values.
+// A nil format falls back to fmt.Sprint.
+func Var[T any](f *FlagSet, ptr *T, name string, def T, usage string, parse ParseFunc[T], format FormatFunc[T]) *scalar.ScalarFlag[T] {
+	parse, format = customHooks(name, parse, format)
+	return engine.RegisterStaticScalar(f.impl, ptr, name, usage, def, parse, format)
+}
+
+// Custom defines a flag of a user-defined type and returns its handle.
+func Custom[T any](f *FlagSet, name string, def T, usage string, parse ParseFunc[T], format FormatFunc[T]) *scalar.ScalarFlag[T] {
+	return Var(f, new(T), name, def, usage, parse, format)
+}
+
+// SliceVar defines a slice flag of a user-defined element type and binds it to the given pointer.
+// parse and format operate on single elements.
+func SliceVar[T any](f *FlagSet, ptr *[]T, name string, def []T, usage string, parse ParseFunc[T], format FormatFunc[T]) *slice.SliceFlag[T] {
+	parse, format = customHooks(name, parse, format)
+	return engine.RegisterStaticSlice(f.impl, ptr, name, usage, def, parse, format, f.impl.DefaultDelimiter(), true)
+}
+
+// CustomSlice defines a slice flag of a user-defined element type and returns its handle.
+func CustomSlice[T any](f *FlagSet, name string, def []T, usage string, parse ParseFunc[T], format FormatFunc[T]) *slice.SliceFlag[T] {
+	return SliceVar(f, new([]T), name, def, usage, parse, format)
+}
+
+// MapVar defines a key/value map flag of user-defined key and value types and binds it to the given pointer.
+// Each occurrence adds "key=value" entries; the first one replaces the defaults.
+func MapVar[K comparable, V any](
+	f *FlagSet,
+	ptr *map[K]V,
+	name string,
+	def map[K]V,
+	usage string,
+	parseKey ParseFunc[K],
+	formatKey FormatFunc[K],
+	parseValue ParseFunc[V],
+	formatValue FormatFunc[V],
+) *mapflag.MapFlag[K, V] {
+	parseKey, formatKey = customHooks(name, parseKey, formatKey)
+	parseValue, formatValue = customHooks(name, parseValue, formatValue)
+	return engine.RegisterStaticMap(f.impl, ptr, name, usage, def, parseKey, formatKey, parseValue, formatValue, f.impl.DefaultDelimiter())
+}
+
+// CustomMap defines a key/value map flag of user-defined types and returns its handle.
+func CustomMap[K comparable, V any](
+	f *FlagSet,
+	name string,
+	def map[K]V,
+	usage string,
+	parseKey ParseFunc[K],
+	formatKey FormatFunc[K],
+	parseValue ParseFunc[V],
+	formatValue FormatFunc[V],
+) *mapflag.MapFlag[K, V] {
+	return MapVar(f, new(map[K]V), name, def, usage, parseKey, formatKey, parseValue, formatValue)
+}
+
+// DynamicVar defines a dynamic group field of a user-defined type.
+func DynamicVar[T any](g *DynamicGroup, field string, def T, usage string, parse ParseFunc[T], format FormatFunc[T]) *dynamic.ScalarFlag[T] {
+	parse, format = customHooks(field, parse, format)
+	return dynamic.Scalar(g, field, def, usage, parse, format)
+}
+
+// DynamicSliceVar defines a dynamic group slice field of a user-defined element type.
+func DynamicSliceVar[T any](g *DynamicGroup, field string, def []T, usage string, parse ParseFunc[T], format FormatFunc[T]) *dynamic.SliceFlag[T] {
+	parse, format = customHooks(field, parse, format)
+	return dynamic.Slice(g, field, def, usage, parse, format)
+}
+
+// DynamicMapVar defines a dynamic group key/value map field of user-defined key and value types.
+func DynamicMapVar[K comparable, V any](
+	g *DynamicGroup,
+	field string,
+	def map[K]V,
+	usage string,
+	parseKey ParseFunc[K],
+	formatKey FormatFunc[K],
+	parseValue ParseFunc[V],
+	formatValue FormatFunc[V],
+) *dynamic.MapFlag[K, V] {
+	parseKey, formatKey = customHooks(field, parseKey, formatKey)
+	parseValue, formatValue = customHooks(field, parseValue, formatValue)
+	return dynamic.Map(g, field, def, usage, parseKey, formatKey, parseValue, formatValue)
+}
+
+// customHooks validates user-supplied hooks and fills in the default formatter.
+func customHooks[T any](name string, parse ParseFunc[T], format FormatFunc[T]) (ParseFunc[T], FormatFunc[T]) {
+	if parse == nil {
+		panic(fmt.Sprintf("tinyflags: parse function for flag %q cannot be nil", name))
+	}
+	if format == nil {
+		format = func(v T) string { return fmt.Sprint(v) }
+	}
+	return parse, format
+}
@@ -0,0 +1,141 @@
+package tinyflags
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containeroo/tinyflags/internal/docs"
+)
+
+// GenMarkdown writes a Markdown reference page for the flag set.
+func (f *FlagSet) GenMarkdown(w io.Writer) error {
+	if w == nil {
+		return nil
+	}
+	return docs.Markdown(w, f.docPage(), docs.MarkdownFile)
+}
+
+// GenHTML writes a self-contained HTML reference page for the flag set.
+func (f *FlagSet) GenHTML(w io.Writer) error {
+	if w == nil {
+		return nil
+	}
+	return docs.HTML(w, f.docPage())
+}
+
+// GenMarkdown writes the Markdown reference page for c.
+// Parent and child commands are linked by their GenMarkdownTree file names.
+func (c *Command) GenMarkdown(w io.Writer) error {
+	if w == nil {
+		return nil
+	}
+	return docs.Markdown(w, c.docPage(), docs.MarkdownFile)
+}
+
+// GenMarkdownTree writes one Markdown page per visible command of the subtree rooted at c into dir.
+// Files are named after the command path with dashes, e.g. "app-serve.md".
+func (c *Command) GenMarkdownTree(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("docs: %w", err)
+	}
+
+	var err error
+	c.docPage().Walk(func(page *docs.Page) {
+		if err != nil {
+			return
+		}
+		var buf bytes.Buffer
+		if err = docs.Markdown(&buf, page, docs.MarkdownFile); err != nil {
+			return
+		}
+		if err = os.WriteFile(filepath.Join(dir, docs.MarkdownFile(page)), buf.Bytes(), 0o644); err != nil {
+			err = fmt.Errorf("docs: %w", err)
+		}
+	})
+	return err
+}
+
+// GenHTML writes a self-contained HTML reference for c and every visible descendant.
+func (c *Command) GenHTML(w io.Writer) error {
+	if w == nil {
+		return nil
+	}
+	return docs.HTML(w, c.buildDocPage(nil, make(map[*Command]*docs.Page)))
+}
+
+// docPage describes the flag set as a single documentation page.
+func (f *FlagSet) docPage() *docs.Page {
+	page := &docs.Page{
+		Name:        f.impl.Name(),
+		Path:        f.impl.Name(),
+		Description: f.impl.DescriptionText(),
+		Note:        f.impl.NoteText(),
+		Authors:     f.impl.AuthorsText(),
+		Version:     f.impl.VersionString(),
+		Flags:       f.impl.DocFlags(),
+		Groups:      f.impl.DocGroups(),
+		Constraints: f.impl.DocConstraints(),
+	}
+	if len(page.Flags) > 0 || len(page.Groups) > 0 {
+		page.Synopsis = "[flags]"
+	}
+	page.Synopsis = strings.TrimSpace(page.Synopsis + f.impl.PositionalUsage())
+	return page
+}
+
+// docPage returns the documentation page of c, linked to its parents and visible children.
+func (c *Command) docPage() *docs.Page {
+	pages := make(map[*Command]*docs.Page)
+	c.root().buildDocPage(nil, pages)
+	if page, ok := pages[c]; ok {
+		return page
+	}
+	return c.buildDocPage(nil, pages)
+}
+
+// buildDocPage converts the command subtree into the documentation model and records each page.
+func (c *Command) buildDocPage(parent *docs.Page, pages map[*Command]*docs.Page) *docs.Page {
+	page := &docs.Page{
+		Name:        c.name,
+		Aliases:     c.aliases,
+		Path:        c.FullName(),
+		Summary:     c.summary,
+		Deprecated:  c.deprecated,
+		Synopsis:    strings.TrimSpace(strings.TrimPrefix(renderUsageLine(c), "Usage: "+c.FullName())),
+		Description: c.impl.DescriptionText(),
+		Note:        c.impl.NoteText(),
+		Authors:     c.impl.AuthorsText(),
+		Version:     c.root().impl.VersionString(),
+		Parent:      parent,
+	}
+	pages[c] = page
+
+	seen := make(map[string]bool)
+	for _, fs := range c.availableFlagSets() {
+		own := fs == c.FlagSet || fs == c.globals
+		for _, fl := range fs.impl.DocFlags() {
+			if seen[fl.Name] {
+				continue
+			}
+			seen[fl.Name] = true
+			if own {
+				page.Flags = append(page.Flags, fl)
+			} else {
+				page.Inherited = append(page.Inherited, fl)
+			}
+		}
+	}
+	for _, fs := range c.parseScopes() {
+		page.Groups = append(page.Groups, fs.impl.DocGroups()...)
+		page.Constraints = append(page.Constraints, fs.impl.DocConstraints()...)
+	}
+
+	for _, child := range c.visibleCommands() {
+		page.Commands = append(page.Commands, child.buildDocPage(page, pages))
+	}
+	return page
+}
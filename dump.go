@@ -0,0 +1,47 @@
+package tinyflags
+
+import (
+	"io"
+
+	"github.com/containeroo/tinyflags/internal/dump"
+)
+
+// DumpFormat selects the output of Dump.
+type DumpFormat = dump.Format
+
+const (
+	DumpJSON   = dump.JSON   // Nested JSON object that ConfigFile can read back
+	DumpDotenv = dump.Dotenv // KEY=value lines using the flags' env keys
+	DumpArgs   = dump.Args   // Shell-quoted arguments that round-trip through Parse
+)
+
+// Dump writes the resolved value of every flag and dynamic instance in the given format.
+// Values pass through OverriddenValueMaskFn. DumpArgs only lists values that were set,
+// so defaults and default finalizers apply again when the output is parsed.
+func (f *FlagSet) Dump(w io.Writer, format DumpFormat) error {
+	return dump.Write(w, format, []dump.Section{{Entries: f.impl.DumpEntries()}})
+}
+
+// Dump writes the resolved flags of the selected command path in the given format.
+// JSON nests each subcommand under its name, matching config file sections;
+// DumpArgs includes the command names so the output reproduces the selection.
+func (c *Command) Dump(w io.Writer, format DumpFormat) error {
+	target := c
+	if c.selected != nil {
+		target = c.selected
+	}
+
+	var sections []dump.Section
+	var path []string
+	for _, cmd := range c.commandPathTo(target) {
+		if cmd != c {
+			path = append(path, cmd.name)
+		}
+		section := dump.Section{Path: append([]string(nil), path...)}
+		for _, fs := range cmd.parseScopes() {
+			section.Entries = append(section.Entries, fs.impl.DumpEntries()...)
+		}
+		sections = append(sections, section)
+	}
+	return dump.Write(w, format, sections)
+}
@@ -0,0 +1,70 @@
+package tinyflags
+
+import (
+	"encoding"
+	"flag"
+
+	"github.com/containeroo/tinyflags/internal/dynamic"
+	"github.com/containeroo/tinyflags/internal/engine"
+	"github.com/containeroo/tinyflags/internal/scalar"
+	"github.com/containeroo/tinyflags/internal/slice"
+	"github.com/containeroo/tinyflags/internal/utils"
+)
+
+// TextUnmarshalerPtr constrains PT to a pointer to T that implements encoding.TextUnmarshaler.
+type TextUnmarshalerPtr[T any] interface {
+	*T
+	encoding.TextUnmarshaler
+}
+
+// Value defines a flag backed by a stdlib flag.Value.
+// Values whose IsBoolFlag method reports true may be set without an argument.
+func (f *FlagSet) Value(v flag.Value, name string, usage string) *scalar.ValueFlag {
+	return f.impl.Value(v, name, usage)
+}
+
+// TextVar defines a flag backed by an encoding.TextUnmarshaler.
+// p is initialized from def, which may be nil to keep p's current value.
+func (f *FlagSet) TextVar(p encoding.TextUnmarshaler, name string, def encoding.TextMarshaler, usage string) *scalar.ValueFlag {
+	return f.impl.TextVar(p, name, def, usage)
+}
+
+// Func defines a flag that calls fn with each supplied value.
+func (f *FlagSet) Func(name string, usage string, fn func(string) error) *scalar.ValueFlag {
+	return f.impl.Func(name, usage, fn)
+}
+
+// BoolFunc defines a flag that calls fn on each occurrence without requiring a value.
+func (f *FlagSet) BoolFunc(name string, usage string, fn func(string) error) *scalar.ValueFlag {
+	return f.impl.BoolFunc(name, usage, fn)
+}
+
+// FuncSlice defines a slice flag that calls fn for each delimited item.
+func (f *FlagSet) FuncSlice(name string, usage string, fn func(string) error) *slice.SliceFlag[string] {
+	return f.impl.FuncSlice(name, usage, fn)
+}
+
+// ValueSlice defines a slice flag that sets a fresh flag.Value for each delimited item.
+func (f *FlagSet) ValueSlice(newValue func() flag.Value, name string, usage string) *slice.SliceFlag[flag.Value] {
+	return f.impl.ValueSlice(newValue, name, usage)
+}
+
+// TextSliceVar defines a slice flag of encoding.TextUnmarshaler elements and binds it to the given pointer.
+func TextSliceVar[T any, PT TextUnmarshalerPtr[T]](f *FlagSet, ptr *[]T, name string, def []T, usage string) *slice.SliceFlag[T] {
+	return engine.RegisterStaticSlice(f.impl, ptr, name, usage, def, utils.ParseText[T, PT], utils.FormatText[T], f.impl.DefaultDelimiter(), true)
+}
+
+// TextSlice defines a slice flag of encoding.TextUnmarshaler elements and returns its handle.
+func TextSlice[T any, PT TextUnmarshalerPtr[T]](f *FlagSet, name string, def []T, usage string) *slice.SliceFlag[T] {
+	return TextSliceVar[T, PT](f, new([]T), name, def, usage)
+}
+
+// DynamicText defines a dynamic group field of an encoding.TextUnmarshaler type.
+func DynamicText[T any, PT TextUnmarshalerPtr[T]](g *DynamicGroup, field string, def T, usage string) *dynamic.ScalarFlag[T] {
+	return dynamic.Scalar(g, field, def, usage, utils.ParseText[T, PT], utils.FormatText[T])
+}
+
+// DynamicTextSlice defines a dynamic group slice field of encoding.TextUnmarshaler elements.
+func DynamicTextSlice[T any, PT TextUnmarshalerPtr[T]](g *DynamicGroup, field string, def []T, usage string) *dynamic.SliceFlag[T] {
+	return dynamic.Slice(g, field, def, usage, utils.ParseText[T, PT], utils.FormatText[T])
+}
@@ -2,6 +2,7 @@ package tinyflags
 
 import (
 	"strings"
+	"time"
 
 	"github.com/containeroo/tinyflags/internal/engine"
 )
@@ -24,6 +25,12 @@ func (f *FlagSet) OnUnknownFlag(fn func(string) error) {
 	f.impl.OnUnknownFlag(fn)
 }
 
+// SetSuggestionDistance sets the maximum edit distance for "did you mean" suggestions (default 2); 0 disables them.
+func (f *FlagSet) SetSuggestionDistance(n int) { f.impl.SetSuggestionDistance(n) }
+
+// DisableSuggestions turns off "did you mean" suggestions for unknown flags and commands.
+func (f *FlagSet) DisableSuggestions() { f.impl.DisableSuggestions() }
+
 // Name returns the flag set's name.
 func (f *FlagSet) Name() string { return f.impl.Name() }
 
@@ -66,6 +73,21 @@ func (f *FlagSet) SetGetEnvFn(fn func(string) string) { f.impl.SetGetEnvFn(fn) }
 // GlobalDelimiter sets the delimiter used for all slice flags.
 func (f *FlagSet) GlobalDelimiter(s string) { f.impl.GlobalDelimiter(s) }
 
+// SetByteUnits sets the unit system Bytes flags use to render sizes (default: ByteUnitsAuto).
+// Child commands inherit it unless they set their own.
+func (f *FlagSet) SetByteUnits(u ByteUnits) { f.impl.SetByteUnits(u) }
+
+// SetTimeLayouts sets the layouts Time and TimeRange flags accept, tried in order (default: time.RFC3339).
+// The first layout renders values in help, dumps and docs; TimeLayoutUnix and TimeLayoutUnixMilli parse epoch timestamps.
+// Child commands inherit the layouts, location and clock unless they set their own.
+func (f *FlagSet) SetTimeLayouts(layouts ...string) { f.impl.SetTimeLayouts(layouts...) }
+
+// SetTimeLocation sets the location for time layouts without a zone, such as time.DateOnly (default: UTC).
+func (f *FlagSet) SetTimeLocation(loc *time.Location) { f.impl.SetTimeLocation(loc) }
+
+// SetNowFn sets the clock that resolves relative times such as now-2h or -30m (default: time.Now).
+func (f *FlagSet) SetNowFn(fn func() time.Time) { f.impl.SetNowFn(fn) }
+
 // DefaultDelimiter returns the delimiter used for slice flags.
 func (f *FlagSet) DefaultDelimiter() string { return f.impl.DefaultDelimiter() }
 
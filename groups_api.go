@@ -82,3 +82,14 @@ func MustGetDynamic[T any](group *dynamic.Group, id, flag string) T {
 func GetOrDefaultDynamic[T any](group *dynamic.Group, id, flag string) T {
 	return dynamic.GetOrDefault[T](group, id, flag)
 }
+
+// DecodeGroup fills a T per instance ID of the group, matching struct fields to dynamic field names
+// via their flag tag or kebab-cased name. Unset fields get their defaults; mismatched types are errors.
+func DecodeGroup[T any](group *dynamic.Group) (map[string]T, error) {
+	return dynamic.Decode[T](group)
+}
+
+// DecodeGroupOrdered is like DecodeGroup but returns the instances sorted by ID.
+func DecodeGroupOrdered[T any](group *dynamic.Group) ([]GroupInstance[T], error) {
+	return dynamic.DecodeOrdered[T](group)
+}
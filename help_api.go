@@ -70,6 +70,11 @@ func (f *FlagSet) PrintDynamicDefaults(w io.Writer, indent, col, width int) {
 	f.impl.PrintDynamicDefaults(w, indent, col, width)
 }
 
+// PrintArguments renders the named positional arguments section.
+func (f *FlagSet) PrintArguments(w io.Writer, indent, width int) {
+	f.impl.PrintArguments(w, indent, width)
+}
+
 // PrintNotes renders the notes section, if configured.
 func (f *FlagSet) PrintNotes(w io.Writer, indent, width int) {
 	f.impl.PrintNotes(w, indent, width)
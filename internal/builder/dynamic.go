@@ -4,15 +4,17 @@ import "github.com/containeroo/tinyflags/internal/core"
 
 // DynamicFlag provides common builder methods for dynamic flags.
 type DynamicFlag[T any] struct {
-	meta flagMeta // shared metadata helpers
+	meta  flagMeta          // shared metadata helpers
+	value core.DynamicValue // per-ID values, used for source lookups
 }
 
 // NewDynamicFlag returns a DynamicFlag ready for embedding.
 func NewDynamicFlag[T any](
 	reg core.Registry,
 	bf *core.BaseFlag,
+	value core.DynamicValue,
 ) *DynamicFlag[T] {
-	return &DynamicFlag[T]{meta: flagMeta{registry: reg, bf: bf}}
+	return &DynamicFlag[T]{meta: flagMeta{registry: reg, bf: bf}, value: value}
 }
 
 // Required marks the flag as mandatory.
@@ -69,6 +71,12 @@ func (d *DynamicFlag[T]) DisableEnv() *DynamicFlag[T] {
 	return d
 }
 
+// DisableConfig ignores values for this flag from config files.
+func (d *DynamicFlag[T]) DisableConfig() *DynamicFlag[T] {
+	d.meta.disableConfig()
+	return d
+}
+
 // HideEnv hides the environment-variable hint in help.
 func (d *DynamicFlag[T]) HideEnv() *DynamicFlag[T] {
 	d.meta.hideEnv()
@@ -124,3 +132,27 @@ func (d *DynamicFlag[T]) HideRequires() *DynamicFlag[T] {
 	d.meta.hideRequires()
 	return d
 }
+
+// Complete registers a runtime completer for this field's value.
+func (d *DynamicFlag[T]) Complete(fn core.CompleteFunc) *DynamicFlag[T] {
+	d.meta.complete(fn)
+	return d
+}
+
+// CompleteFiles completes this field's value with file names, optionally filtered by extension.
+func (d *DynamicFlag[T]) CompleteFiles(exts ...string) *DynamicFlag[T] {
+	d.meta.completeFiles(exts...)
+	return d
+}
+
+// CompleteDirs completes this field's value with directory names.
+func (d *DynamicFlag[T]) CompleteDirs() *DynamicFlag[T] {
+	d.meta.completeDirs()
+	return d
+}
+
+// Source returns where the value for id came from in the last parse, and whether it was set.
+func (d *DynamicFlag[T]) Source(id string) (core.Source, bool) {
+	val, _ := d.value.GetAny(id)
+	return d.meta.bf.InstanceSource(id, val)
+}
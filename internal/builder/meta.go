@@ -69,6 +69,9 @@ func (m *flagMeta) disableEnv() {
 	m.bf.DisableEnv = true
 }
 
+// disableConfig ignores config file values for the flag.
+func (m *flagMeta) disableConfig() { m.bf.DisableConfig = true }
+
 // hideEnv hides the environment variable hint in help output.
 func (m *flagMeta) hideEnv() { m.bf.HideEnv = true }
 
@@ -100,6 +103,27 @@ func (m *flagMeta) section(name string) { m.bf.Section = name }
 // maskFn sets the masking function for overridden values.
 func (m *flagMeta) maskFn(fn func(any) any) { m.bf.MaskFn = fn }
 
+// complete installs a runtime value completer and disables file fallback.
+func (m *flagMeta) complete(fn core.CompleteFunc) {
+	m.bf.Completer = fn
+	m.bf.CompleteHint |= core.CompleteNoFile
+}
+
+// completeFiles restricts value completion to files, optionally filtered by extension.
+func (m *flagMeta) completeFiles(exts ...string) {
+	m.bf.CompleteExts = append([]string(nil), exts...)
+	m.bf.CompleteHint = core.CompleteDefault
+	if len(exts) > 0 {
+		m.bf.CompleteHint = core.CompleteFilterExt
+	}
+}
+
+// completeDirs restricts value completion to directories.
+func (m *flagMeta) completeDirs() {
+	m.bf.CompleteExts = nil
+	m.bf.CompleteHint = core.CompleteFilterDirs
+}
+
 func appendBaseFlagUnique(flags []*core.BaseFlag, target *core.BaseFlag) []*core.BaseFlag {
 	for _, flag := range flags {
 		if flag == target {
@@ -81,6 +81,12 @@ func (s *StaticFlag[T, Self]) DisableEnv() Self {
 	return s.self
 }
 
+// DisableConfig ignores values for this flag from config files.
+func (s *StaticFlag[T, Self]) DisableConfig() Self {
+	s.meta.disableConfig()
+	return s.self
+}
+
 // HideEnv hides the environment-variable hint in help.
 func (s *StaticFlag[T, Self]) HideEnv() Self {
 	s.meta.hideEnv()
@@ -141,3 +147,26 @@ func (s *StaticFlag[T, Self]) OverriddenValueMaskFn(fn func(any) any) Self {
 	s.meta.maskFn(fn)
 	return s.self
 }
+
+// Complete registers a runtime completer for this flag's value.
+func (s *StaticFlag[T, Self]) Complete(fn core.CompleteFunc) Self {
+	s.meta.complete(fn)
+	return s.self
+}
+
+// CompleteFiles completes this flag's value with file names, optionally filtered by extension.
+func (s *StaticFlag[T, Self]) CompleteFiles(exts ...string) Self {
+	s.meta.completeFiles(exts...)
+	return s.self
+}
+
+// CompleteDirs completes this flag's value with directory names.
+func (s *StaticFlag[T, Self]) CompleteDirs() Self {
+	s.meta.completeDirs()
+	return s.self
+}
+
+// Source returns where the value came from in the last parse.
+func (s *StaticFlag[T, Self]) Source() core.Source {
+	return s.meta.bf.Source()
+}
@@ -0,0 +1,182 @@
+package completion
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Bash renders a bash completion script for the command tree rooted at root.
+func Bash(root *Command) string {
+	fn := "_" + identifier(root.Name) + "_completion"
+	runtimeFn := fn + "_runtime"
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# bash completion for %s\n", root.Name)
+	fmt.Fprintf(&b, "# Load with: source <(%s completion bash)\n\n", root.Name)
+	if root.usesRuntime() {
+		writeBashRuntime(&b, runtimeFn)
+	}
+	fmt.Fprintf(&b, "%s() {\n", fn)
+	b.WriteString(`    local cur prev flag cmdpath skip i word
+    COMPREPLY=()
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev=""
+    if ((COMP_CWORD > 0)); then
+        prev="${COMP_WORDS[COMP_CWORD - 1]}"
+    fi
+    flag="$prev"
+    if [[ $cur == "=" ]]; then
+        cur=""
+    elif [[ $prev == "=" ]] && ((COMP_CWORD > 1)); then
+        flag="${COMP_WORDS[COMP_CWORD - 2]}"
+    fi
+
+`)
+	fmt.Fprintf(&b, "    cmdpath=%s\n", singleQuote(root.Path))
+	b.WriteString(`    skip=0
+    for ((i = 1; i < COMP_CWORD; i++)); do
+        word="${COMP_WORDS[i]}"
+        if [[ $word == "=" ]]; then
+            skip=1
+            continue
+        fi
+        if ((skip)); then
+            skip=0
+            continue
+        fi
+        case "$cmdpath" in
+`)
+	root.Walk(func(c *Command) {
+		valueFlags := c.valueFlags()
+		if len(c.Commands) == 0 && len(valueFlags) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "        %s)\n", singleQuote(c.Path))
+		b.WriteString("            case \"$word\" in\n")
+		for _, child := range c.Commands {
+			fmt.Fprintf(&b, "            %s) cmdpath=%s ;;\n", bashPattern(child.Words()), singleQuote(child.Path))
+		}
+		for _, fl := range valueFlags {
+			fmt.Fprintf(&b, "            %s) skip=1 ;;\n", bashPattern(fl.Names()))
+		}
+		b.WriteString("            esac\n")
+		b.WriteString("            ;;\n")
+	})
+	b.WriteString(`        esac
+    done
+
+    case "$cmdpath" in
+`)
+	root.Walk(func(c *Command) {
+		fmt.Fprintf(&b, "    %s)\n", singleQuote(c.Path))
+		if valueFlags := c.valueFlags(); len(valueFlags) > 0 {
+			b.WriteString("        case \"$flag\" in\n")
+			for _, fl := range valueFlags {
+				fmt.Fprintf(&b, "        %s)\n", bashPattern(fl.Names()))
+				switch {
+				case fl.Runtime:
+					fmt.Fprintf(&b, "            %s\n", runtimeFn)
+				case len(fl.Values) > 0:
+					fmt.Fprintf(&b, "            COMPREPLY=($(compgen -W %s -- \"$cur\"))\n", singleQuote(strings.Join(fl.Values, " ")))
+				}
+				b.WriteString("            return 0\n")
+				b.WriteString("            ;;\n")
+			}
+			b.WriteString("        esac\n")
+		}
+		b.WriteString("        if [[ $cur == -* ]]; then\n")
+		if c.RuntimeFlags {
+			fmt.Fprintf(&b, "            %s\n", runtimeFn)
+		} else {
+			fmt.Fprintf(&b, "            COMPREPLY=($(compgen -W %s -- \"$cur\"))\n", singleQuote(strings.Join(c.flagWords(), " ")))
+		}
+		switch {
+		case c.RuntimeArgs:
+			b.WriteString("        else\n")
+			fmt.Fprintf(&b, "            %s\n", runtimeFn)
+		case len(c.Commands) > 0:
+			b.WriteString("        else\n")
+			fmt.Fprintf(&b, "            COMPREPLY=($(compgen -W %s -- \"$cur\"))\n", singleQuote(strings.Join(c.commandWords(), " ")))
+		}
+		b.WriteString("        fi\n")
+		b.WriteString("        ;;\n")
+	})
+	b.WriteString("    esac\n")
+	b.WriteString("    return 0\n")
+	b.WriteString("}\n\n")
+	fmt.Fprintf(&b, "complete -o default -F %s %s\n", fn, root.Name)
+	return b.String()
+}
+
+// writeBashRuntime renders the helper that asks the program for candidates via __complete.
+// Words split at "=" by bash are glued back together before they are passed on.
+func writeBashRuntime(b *strings.Builder, name string) {
+	fmt.Fprintf(b, "%s() {\n", name)
+	b.WriteString(`    local -a args=() lines=()
+    local i word glue=0 out line directive strip
+    for ((i = 1; i <= COMP_CWORD; i++)); do
+        word="${COMP_WORDS[i]}"
+        if [[ $word == "=" ]] && ((${#args[@]} > 0)); then
+            args[${#args[@]} - 1]+="="
+            glue=1
+            continue
+        fi
+        if ((glue)); then
+            args[${#args[@]} - 1]+="$word"
+            glue=0
+            continue
+        fi
+        args+=("$word")
+    done
+    strip="${args[${#args[@]} - 1]}"
+    strip="${strip%"$cur"}"
+
+    out="$("${COMP_WORDS[0]}" __complete "${args[@]}" 2>/dev/null)" || return 0
+    while IFS= read -r line; do
+        lines+=("$line")
+    done <<<"$out"
+    ((${#lines[@]} > 0)) || return 0
+    directive="${lines[${#lines[@]} - 1]#:}"
+    unset "lines[${#lines[@]} - 1]"
+
+    COMPREPLY=()
+    if ((directive & 1)); then
+        return 0
+    fi
+    if ((directive & 8)); then
+        for line in "${lines[@]}"; do
+            COMPREPLY+=($(compgen -f -X "!*.${line%%$'\t'*}" -- "$cur"))
+        done
+        COMPREPLY+=($(compgen -d -- "$cur"))
+        compopt -o filenames 2>/dev/null
+        return 0
+    fi
+    if ((directive & 16)); then
+        COMPREPLY=($(compgen -d -- "$cur"))
+        compopt -o filenames 2>/dev/null
+        return 0
+    fi
+    for line in "${lines[@]}"; do
+        line="${line%%$'\t'*}"
+        COMPREPLY+=("${line#"$strip"}")
+    done
+    if ((directive & 2)); then
+        compopt -o nospace 2>/dev/null
+    fi
+    if ((directive & 4)); then
+        compopt +o default 2>/dev/null
+    fi
+    return 0
+}
+
+`)
+}
+
+// bashPattern joins quoted words into one case pattern.
+func bashPattern(words []string) string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = singleQuote(w)
+	}
+	return strings.Join(quoted, "|")
+}
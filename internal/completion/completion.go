@@ -0,0 +1,146 @@
+package completion
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Shells lists the supported completion shells in display order.
+var Shells = []string{"bash", "zsh", "fish", "powershell"}
+
+// Command describes one node of a command tree for completion scripts.
+type Command struct {
+	Name     string     // Command segment name.
+	Aliases  []string   // Alternative segment names routed to this command.
+	Path     string     // Full command path, starting with the program name.
+	Summary  string     // Short summary shown next to the command.
+	Flags    []Flag     // Flags accepted at this node, including inherited ones.
+	Commands []*Command // Visible child commands.
+
+	RuntimeArgs  bool // Positional arguments are completed by the program at runtime.
+	RuntimeFlags bool // Flag names include dynamic groups completed at runtime.
+}
+
+// Flag describes one completable flag.
+type Flag struct {
+	Name       string   // Long name without dashes.
+	Short      string   // Short alias without dash.
+	Usage      string   // Description shown by shells that support it.
+	TakesValue bool     // Whether the flag consumes the following token.
+	Values     []string // Allowed values offered after the flag.
+	Runtime    bool     // Value is completed by the program at runtime.
+}
+
+// Generate writes the completion script for shell to w.
+func Generate(w io.Writer, shell string, root *Command) error {
+	var script string
+	switch strings.ToLower(shell) {
+	case "bash":
+		script = Bash(root)
+	case "zsh":
+		script = Zsh(root)
+	case "fish":
+		script = Fish(root)
+	case "powershell", "pwsh":
+		script = PowerShell(root)
+	default:
+		return fmt.Errorf("unsupported shell %q (supported: %s)", shell, strings.Join(Shells, ", "))
+	}
+	_, err := io.WriteString(w, script)
+	return err
+}
+
+// usesRuntime reports whether any node delegates completion to the program.
+func (c *Command) usesRuntime() bool {
+	uses := false
+	c.Walk(func(n *Command) {
+		if n.RuntimeArgs || n.RuntimeFlags {
+			uses = true
+		}
+		for _, fl := range n.Flags {
+			if fl.Runtime {
+				uses = true
+			}
+		}
+	})
+	return uses
+}
+
+// Names returns the dashed long and short spellings of the flag.
+func (f Flag) Names() []string {
+	names := []string{"--" + f.Name}
+	if f.Short != "" {
+		names = append(names, "-"+f.Short)
+	}
+	return names
+}
+
+// Walk calls fn for root and every descendant in depth-first order.
+func (c *Command) Walk(fn func(*Command)) {
+	fn(c)
+	for _, child := range c.Commands {
+		child.Walk(fn)
+	}
+}
+
+// Words returns the command name followed by its aliases.
+func (c *Command) Words() []string {
+	return append([]string{c.Name}, c.Aliases...)
+}
+
+// valueFlags returns the flags that consume a following token.
+func (c *Command) valueFlags() []Flag {
+	var out []Flag
+	for _, fl := range c.Flags {
+		if fl.TakesValue {
+			out = append(out, fl)
+		}
+	}
+	return out
+}
+
+// flagWords returns all dashed flag spellings accepted at this node.
+func (c *Command) flagWords() []string {
+	var out []string
+	for _, fl := range c.Flags {
+		out = append(out, fl.Names()...)
+	}
+	return out
+}
+
+// commandWords returns the names of the visible child commands.
+func (c *Command) commandWords() []string {
+	out := make([]string, 0, len(c.Commands))
+	for _, child := range c.Commands {
+		out = append(out, child.Name)
+	}
+	return out
+}
+
+// identifier turns a program name into a shell-safe function name fragment.
+func identifier(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+// singleQuote quotes s for POSIX-like shells using single quotes.
+func singleQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// firstLine trims a description to its first line for compact shell menus.
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return strings.TrimSpace(s[:i])
+	}
+	return strings.TrimSpace(s)
+}
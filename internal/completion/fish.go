@@ -0,0 +1,161 @@
+package completion
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Fish renders a fish completion script for the command tree rooted at root.
+func Fish(root *Command) string {
+	id := identifier(root.Name)
+	pathFn := "__" + id + "_cmdpath"
+	usingFn := "__" + id + "_using"
+	runtimeFn := "__" + id + "_runtime"
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# fish completion for %s\n", root.Name)
+	fmt.Fprintf(&b, "# Load with: %s completion fish | source\n\n", root.Name)
+	fmt.Fprintf(&b, "function %s\n", pathFn)
+	b.WriteString("    set -l tokens (commandline -opc)\n")
+	b.WriteString("    set -e tokens[1]\n")
+	fmt.Fprintf(&b, "    set -l cmdpath %s\n", fishQuote(root.Path))
+	b.WriteString(`    set -l skip 0
+    for word in $tokens
+        if test $skip -eq 1
+            set skip 0
+            continue
+        end
+        switch $cmdpath
+`)
+	root.Walk(func(c *Command) {
+		valueFlags := c.valueFlags()
+		if len(c.Commands) == 0 && len(valueFlags) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "            case %s\n", fishQuote(c.Path))
+		b.WriteString("                switch $word\n")
+		for _, child := range c.Commands {
+			words := child.Words()
+			for i, word := range words {
+				words[i] = fishQuote(word)
+			}
+			fmt.Fprintf(&b, "                    case %s\n", strings.Join(words, " "))
+			fmt.Fprintf(&b, "                        set cmdpath %s\n", fishQuote(child.Path))
+		}
+		for _, fl := range valueFlags {
+			names := fl.Names()
+			for i, name := range names {
+				names[i] = fishQuote(name)
+			}
+			fmt.Fprintf(&b, "                    case %s\n", strings.Join(names, " "))
+			b.WriteString("                        set skip 1\n")
+		}
+		b.WriteString("                end\n")
+	})
+	b.WriteString(`        end
+    end
+    echo $cmdpath
+end
+
+`)
+	fmt.Fprintf(&b, "function %s\n", usingFn)
+	fmt.Fprintf(&b, "    test (%s) = \"$argv[1]\"\n", pathFn)
+	b.WriteString("end\n\n")
+	if root.usesRuntime() {
+		writeFishRuntime(&b, runtimeFn)
+	}
+
+	root.Walk(func(c *Command) {
+		cond := fishQuote(usingFn + " " + fishQuote(c.Path))
+		if len(c.Commands) > 0 || c.RuntimeArgs {
+			fmt.Fprintf(&b, "complete -c %s -n %s -f\n", root.Name, cond)
+		}
+		if c.RuntimeArgs {
+			fmt.Fprintf(&b, "complete -c %s -n %s -f -a %s\n", root.Name, cond, fishQuote("("+runtimeFn+")"))
+		}
+		if c.RuntimeFlags {
+			runtimeCond := fishQuote(usingFn + " " + fishQuote(c.Path) + "; and string match -q -- '-*' (commandline -ct)")
+			fmt.Fprintf(&b, "complete -c %s -n %s -f -a %s\n", root.Name, runtimeCond, fishQuote("("+runtimeFn+")"))
+		}
+		if !c.RuntimeArgs {
+			for _, child := range c.Commands {
+				fmt.Fprintf(&b, "complete -c %s -n %s -f -a %s", root.Name, cond, fishQuote(child.Name))
+				writeFishDesc(&b, child.Summary)
+			}
+		}
+		for _, fl := range c.Flags {
+			fmt.Fprintf(&b, "complete -c %s -n %s -l %s", root.Name, cond, fishQuote(fl.Name))
+			if fl.Short != "" {
+				fmt.Fprintf(&b, " -s %s", fishQuote(fl.Short))
+			}
+			switch {
+			case fl.Runtime:
+				fmt.Fprintf(&b, " -x -a %s", fishQuote("("+runtimeFn+")"))
+			case len(fl.Values) > 0:
+				fmt.Fprintf(&b, " -x -a %s", fishQuote(strings.Join(fl.Values, " ")))
+			case fl.TakesValue:
+				b.WriteString(" -r")
+			}
+			writeFishDesc(&b, fl.Usage)
+		}
+	})
+	return b.String()
+}
+
+// writeFishRuntime renders the helper that asks the program for candidates via __complete.
+func writeFishRuntime(b *strings.Builder, name string) {
+	fmt.Fprintf(b, "function %s\n", name)
+	b.WriteString(`    set -l args (commandline -opc)
+    set -l prog $args[1]
+    set -e args[1]
+    set -l token (commandline -ct)
+    set -l out (command $prog __complete $args "$token" 2>/dev/null)
+    or return
+    test (count $out) -gt 0
+    or return
+    set -l directive (string replace -- ':' '' $out[-1])
+    set -e out[-1]
+
+    if test (math "bitand($directive, 1)") -ne 0
+        return
+    end
+    if test (math "bitand($directive, 8)") -ne 0
+        for ext in $out
+            __fish_complete_suffix (string split -f1 \t -- $ext | string replace -r '^' '.')
+        end
+        return
+    end
+    if test (math "bitand($directive, 16)") -ne 0
+        __fish_complete_directories $token
+        return
+    end
+    set -l strip (string replace -r '=.*' '=' -- $token)
+    for line in $out
+        if string match -q -- '-*=*' $token
+            string replace -- $strip '' $line
+        else
+            echo $line
+        end
+    end
+    if test (count $out) -eq 0; and test (math "bitand($directive, 4)") -eq 0
+        __fish_complete_path $token
+    end
+end
+
+`)
+}
+
+// writeFishDesc terminates one complete line, adding a description when present.
+func writeFishDesc(b *strings.Builder, desc string) {
+	if desc = firstLine(desc); desc != "" {
+		fmt.Fprintf(b, " -d %s", fishQuote(desc))
+	}
+	b.WriteByte('\n')
+}
+
+// fishQuote quotes s for fish using single quotes.
+func fishQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "'", `\'`)
+	return "'" + s + "'"
+}
@@ -0,0 +1,174 @@
+package completion
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PowerShell renders a PowerShell completion script for the command tree rooted at root.
+func PowerShell(root *Command) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# powershell completion for %s\n", root.Name)
+	fmt.Fprintf(&b, "# Load with: %s completion powershell | Out-String | Invoke-Expression\n\n", root.Name)
+	fmt.Fprintf(&b, "Register-ArgumentCompleter -Native -CommandName %s -ScriptBlock {\n", psQuote(root.Name))
+	b.WriteString(`    param($wordToComplete, $commandAst, $cursorPosition)
+
+    $words = @($commandAst.CommandElements |
+        Where-Object { $_.Extent.StartOffset -lt $cursorPosition } |
+        ForEach-Object { $_.ToString() })
+    if ($wordToComplete -ne '') {
+        $words = @($words | Select-Object -SkipLast 1)
+    }
+
+    $cur = $wordToComplete
+    $prefix = ''
+    $flag = ''
+    if ($words.Count -gt 1) {
+        $flag = $words[-1]
+    }
+    if ($cur -like '-*=*') {
+        $idx = $cur.IndexOf('=')
+        $flag = $cur.Substring(0, $idx)
+        $prefix = $cur.Substring(0, $idx + 1)
+        $cur = $cur.Substring($idx + 1)
+    }
+
+`)
+	fmt.Fprintf(&b, "    $cmdpath = %s\n", psQuote(root.Path))
+	b.WriteString(`    $skip = $false
+    for ($i = 1; $i -lt $words.Count; $i++) {
+        $word = $words[$i]
+        if ($skip) {
+            $skip = $false
+            continue
+        }
+        switch -CaseSensitive -Exact ($cmdpath) {
+`)
+	root.Walk(func(c *Command) {
+		valueFlags := c.valueFlags()
+		if len(c.Commands) == 0 && len(valueFlags) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "            %s {\n", psQuote(c.Path))
+		b.WriteString("                switch -CaseSensitive -Exact ($word) {\n")
+		for _, child := range c.Commands {
+			for _, word := range child.Words() {
+				fmt.Fprintf(&b, "                    %s { $cmdpath = %s }\n", psQuote(word), psQuote(child.Path))
+			}
+		}
+		for _, fl := range valueFlags {
+			for _, name := range fl.Names() {
+				fmt.Fprintf(&b, "                    %s { $skip = $true }\n", psQuote(name))
+			}
+		}
+		b.WriteString("                }\n")
+		b.WriteString("            }\n")
+	})
+	b.WriteString(`        }
+    }
+
+    $results = [System.Collections.Generic.List[System.Management.Automation.CompletionResult]]::new()
+    $add = {
+        param($text, $tooltip, $type)
+        if ($text.StartsWith($cur, [System.StringComparison]::Ordinal)) {
+            if ([string]::IsNullOrEmpty($tooltip)) {
+                $tooltip = $text
+            }
+            $results.Add([System.Management.Automation.CompletionResult]::new($prefix + $text, $text, $type, $tooltip))
+        }
+    }
+
+`)
+	if root.usesRuntime() {
+		writePowerShellRuntime(&b)
+	}
+	b.WriteString(`    $valueFlag = $false
+    switch -CaseSensitive -Exact ($cmdpath) {
+`)
+	root.Walk(func(c *Command) {
+		fmt.Fprintf(&b, "        %s {\n", psQuote(c.Path))
+		if valueFlags := c.valueFlags(); len(valueFlags) > 0 {
+			b.WriteString("            switch -CaseSensitive -Exact ($flag) {\n")
+			for _, fl := range valueFlags {
+				for _, name := range fl.Names() {
+					fmt.Fprintf(&b, "                %s {\n", psQuote(name))
+					b.WriteString("                    $valueFlag = $true\n")
+					if fl.Runtime {
+						b.WriteString("                    & $runtime\n")
+					}
+					for _, v := range fl.Values {
+						fmt.Fprintf(&b, "                    & $add %s '' 'ParameterValue'\n", psQuote(v))
+					}
+					b.WriteString("                }\n")
+				}
+			}
+			b.WriteString("            }\n")
+		}
+		b.WriteString("            if (-not $valueFlag) {\n")
+		b.WriteString("                if ($cur.StartsWith('-')) {\n")
+		if c.RuntimeFlags {
+			b.WriteString("                    & $runtime\n")
+		} else {
+			for _, fl := range c.Flags {
+				for _, name := range fl.Names() {
+					fmt.Fprintf(&b, "                    & $add %s %s 'ParameterName'\n", psQuote(name), psQuote(firstLine(fl.Usage)))
+				}
+			}
+		}
+		b.WriteString("                }")
+		if c.RuntimeArgs {
+			b.WriteString(" else {\n")
+			b.WriteString("                    & $runtime\n")
+			b.WriteString("                }")
+		} else if len(c.Commands) > 0 {
+			b.WriteString(" else {\n")
+			for _, child := range c.Commands {
+				fmt.Fprintf(&b, "                    & $add %s %s 'Command'\n", psQuote(child.Name), psQuote(firstLine(child.Summary)))
+			}
+			b.WriteString("                }")
+		}
+		b.WriteString("\n")
+		b.WriteString("            }\n")
+		b.WriteString("        }\n")
+	})
+	b.WriteString(`    }
+    $results
+}
+`)
+	return b.String()
+}
+
+// writePowerShellRuntime renders the script block that asks the program for candidates via __complete.
+// File and directory directives return nothing so PowerShell falls back to path completion.
+func writePowerShellRuntime(b *strings.Builder) {
+	b.WriteString(`    $runtime = {
+        $partial = $wordToComplete
+        if ($partial -eq '') {
+            $partial = '""'
+        }
+        $program = $commandAst.CommandElements[0].ToString()
+        $out = @(& $program '__complete' @($words | Select-Object -Skip 1) $partial 2>$null)
+        if ($out.Count -eq 0) {
+            return
+        }
+        $directive = [int]($out[-1].TrimStart(':'))
+        if ($directive -band 25) {
+            return
+        }
+        foreach ($line in @($out | Select-Object -SkipLast 1)) {
+            $parts = $line -split "` + "`" + `t", 2
+            $tooltip = $parts[0]
+            if ($parts.Count -gt 1 -and $parts[1] -ne '') {
+                $tooltip = $parts[1]
+            }
+            $results.Add([System.Management.Automation.CompletionResult]::new($parts[0], $parts[0], 'ParameterValue', $tooltip))
+        }
+    }
+
+`)
+}
+
+// psQuote quotes s as a PowerShell single-quoted string.
+func psQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
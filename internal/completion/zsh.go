@@ -0,0 +1,171 @@
+package completion
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Zsh renders a zsh completion script for the command tree rooted at root.
+func Zsh(root *Command) string {
+	fn := "_" + identifier(root.Name)
+	runtimeFn := fn + "_runtime"
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "#compdef %s\n", root.Name)
+	fmt.Fprintf(&b, "# zsh completion for %s\n", root.Name)
+	fmt.Fprintf(&b, "# Load with: source <(%s completion zsh)\n\n", root.Name)
+	if root.usesRuntime() {
+		writeZshRuntime(&b, runtimeFn)
+	}
+	fmt.Fprintf(&b, "%s() {\n", fn)
+	b.WriteString(`    local cur prev flag cmdpath word
+    local -i skip=0 i
+    cur="${words[CURRENT]}"
+    prev="${words[CURRENT-1]}"
+    flag="$prev"
+    if [[ $cur == -*=* ]]; then
+        flag="${cur%%=*}"
+        compset -P '*='
+    fi
+
+`)
+	fmt.Fprintf(&b, "    cmdpath=%s\n", singleQuote(root.Path))
+	b.WriteString(`    for ((i = 2; i < CURRENT; i++)); do
+        word="${words[i]}"
+        if ((skip)); then
+            skip=0
+            continue
+        fi
+        case "$cmdpath" in
+`)
+	root.Walk(func(c *Command) {
+		valueFlags := c.valueFlags()
+		if len(c.Commands) == 0 && len(valueFlags) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "        %s)\n", singleQuote(c.Path))
+		b.WriteString("            case \"$word\" in\n")
+		for _, child := range c.Commands {
+			fmt.Fprintf(&b, "            %s) cmdpath=%s ;;\n", bashPattern(child.Words()), singleQuote(child.Path))
+		}
+		for _, fl := range valueFlags {
+			fmt.Fprintf(&b, "            %s) skip=1 ;;\n", bashPattern(fl.Names()))
+		}
+		b.WriteString("            esac\n")
+		b.WriteString("            ;;\n")
+	})
+	b.WriteString(`        esac
+    done
+
+    case "$cmdpath" in
+`)
+	root.Walk(func(c *Command) {
+		fmt.Fprintf(&b, "    %s)\n", singleQuote(c.Path))
+		if valueFlags := c.valueFlags(); len(valueFlags) > 0 {
+			b.WriteString("        case \"$flag\" in\n")
+			for _, fl := range valueFlags {
+				fmt.Fprintf(&b, "        %s)\n", bashPattern(fl.Names()))
+				if fl.Runtime {
+					fmt.Fprintf(&b, "            %s\n", runtimeFn)
+				} else if len(fl.Values) > 0 {
+					quoted := make([]string, len(fl.Values))
+					for i, v := range fl.Values {
+						quoted[i] = singleQuote(v)
+					}
+					fmt.Fprintf(&b, "            compadd -- %s\n", strings.Join(quoted, " "))
+				} else {
+					b.WriteString("            _files\n")
+				}
+				b.WriteString("            return\n")
+				b.WriteString("            ;;\n")
+			}
+			b.WriteString("        esac\n")
+		}
+		b.WriteString("        if [[ $cur == -* ]]; then\n")
+		if c.RuntimeFlags {
+			fmt.Fprintf(&b, "            %s\n", runtimeFn)
+		} else {
+			b.WriteString("            local -a opts=(\n")
+			for _, fl := range c.Flags {
+				for _, name := range fl.Names() {
+					fmt.Fprintf(&b, "                %s\n", singleQuote(zshItem(name, fl.Usage)))
+				}
+			}
+			b.WriteString("            )\n")
+			b.WriteString("            _describe -t flags 'flag' opts\n")
+		}
+		b.WriteString("        else\n")
+		if c.RuntimeArgs {
+			fmt.Fprintf(&b, "            %s\n", runtimeFn)
+		} else if len(c.Commands) > 0 {
+			b.WriteString("            local -a cmds=(\n")
+			for _, child := range c.Commands {
+				fmt.Fprintf(&b, "                %s\n", singleQuote(zshItem(child.Name, child.Summary)))
+			}
+			b.WriteString("            )\n")
+			b.WriteString("            _describe -t commands 'command' cmds\n")
+		} else {
+			b.WriteString("            _files\n")
+		}
+		b.WriteString("        fi\n")
+		b.WriteString("        ;;\n")
+	})
+	b.WriteString("    esac\n")
+	b.WriteString("}\n\n")
+	b.WriteString("if [[ \"${zsh_eval_context[-1]}\" == \"loadautofunc\" ]]; then\n")
+	fmt.Fprintf(&b, "    %s \"$@\"\n", fn)
+	b.WriteString("else\n")
+	fmt.Fprintf(&b, "    compdef %s %s\n", fn, root.Name)
+	b.WriteString("fi\n")
+	return b.String()
+}
+
+// writeZshRuntime renders the helper that asks the program for candidates via __complete.
+func writeZshRuntime(b *strings.Builder, name string) {
+	fmt.Fprintf(b, "%s() {\n", name)
+	b.WriteString(`    local -a lines comps exts nospace
+    local out directive line value desc
+    out="$("${words[1]}" __complete "${(@)words[2,CURRENT]}" 2>/dev/null)" || return 1
+    lines=("${(@f)out}")
+    (( ${#lines} > 0 )) || return 1
+    directive="${lines[-1]#:}"
+    lines=("${(@)lines[1,-2]}")
+
+    (( directive & 1 )) && return 1
+    if (( directive & 8 )); then
+        for line in "${lines[@]}"; do
+            exts+=("${line%%$'\t'*}")
+        done
+        _files -g "*.(${(j:|:)exts})"
+        return
+    fi
+    if (( directive & 16 )); then
+        _files -/
+        return
+    fi
+    for line in "${lines[@]}"; do
+        value="${line%%$'\t'*}"
+        value="${value#"$IPREFIX"}"
+        desc=""
+        [[ $line == *$'\t'* ]] && desc="${line#*$'\t'}"
+        comps+=("${value//:/\\:}${desc:+:$desc}")
+    done
+    (( directive & 2 )) && nospace=(-S '')
+    if (( ${#comps} > 0 )); then
+        _describe -t values 'value' comps "${nospace[@]}"
+    elif (( ! (directive & 4) )); then
+        _files
+    fi
+}
+
+`)
+}
+
+// zshItem formats one _describe entry, escaping colons in the name.
+func zshItem(name, desc string) string {
+	name = strings.ReplaceAll(name, ":", `\:`)
+	if desc = firstLine(desc); desc == "" {
+		return name
+	}
+	return name + ":" + desc
+}
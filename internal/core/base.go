@@ -23,6 +23,22 @@ type BaseFlag struct {
 	HideRequires bool               // Hide “(Requires)” in help.
 	HideDefault  bool               // Hide default value in help.
 	Section      string             // Optional section name for grouping in help.
+	Format       string             // Value syntax beyond the Go type, e.g. FormatBytes.
 	MaskFn       func(any) any      // Optional mask for overridden values.
 	HelpOneOfSet bool               // Whether HelpOneOf overrides default OneOf help rendering.
+
+	// Shell completion settings.
+	Completer    CompleteFunc        // Optional runtime value completer.
+	CompleteHint CompletionDirective // Shell directive used when completing the value.
+	CompleteExts []string            // File extensions offered with CompleteFilterExt.
+
+	// Config file settings.
+	DisableConfig bool // If true, ignore values from config files.
+
+	// Value provenance recorded by the last parse.
+	source          Source
+	instanceSources map[string]Source
 }
+
+// FormatBytes marks byte-size flags whose values may be written with units, e.g. "10MiB".
+const FormatBytes = "bytes"
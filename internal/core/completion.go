@@ -0,0 +1,24 @@
+package core
+
+import "context"
+
+// Completion is one candidate offered for the word being completed.
+type Completion struct {
+	Value       string // Text inserted on the command line.
+	Description string // Optional description shown by shells that support it.
+}
+
+// CompletionDirective tells the shell how to treat a completion result.
+type CompletionDirective int
+
+const (
+	CompleteDefault    CompletionDirective = 0      // Fall back to file completion when no candidates match.
+	CompleteError      CompletionDirective = 1 << 0 // Completion failed; offer nothing.
+	CompleteNoSpace    CompletionDirective = 1 << 1 // Do not append a space after the candidate.
+	CompleteNoFile     CompletionDirective = 1 << 2 // Never fall back to file completion.
+	CompleteFilterExt  CompletionDirective = 1 << 3 // Candidates are file extensions to filter by.
+	CompleteFilterDirs CompletionDirective = 1 << 4 // Complete directory names only.
+)
+
+// CompleteFunc returns completion candidates for the partial word prefix.
+type CompleteFunc func(ctx context.Context, prefix string) []Completion
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ConfigDecoder turns a configuration document into nested key/value maps.
+type ConfigDecoder interface {
+	Decode(r io.Reader) (map[string]any, error)
+}
+
+// ConfigLineDecoder is an optional ConfigDecoder extension that also reports
+// the line of every non-object value, keyed by its dot-joined path.
+type ConfigLineDecoder interface {
+	DecodeLines(r io.Reader) (map[string]any, map[string]int, error)
+}
+
+// ConfigDecoderFunc adapts a plain function to ConfigDecoder.
+type ConfigDecoderFunc func(r io.Reader) (map[string]any, error)
+
+// Decode calls fn(r).
+func (fn ConfigDecoderFunc) Decode(r io.Reader) (map[string]any, error) { return fn(r) }
+
+// JSONDecoder decodes JSON objects with encoding/json, keeping numbers exact.
+type JSONDecoder struct{}
+
+// Decode reads one JSON object from r.
+func (d JSONDecoder) Decode(r io.Reader) (map[string]any, error) {
+	doc, _, err := d.DecodeLines(r)
+	return doc, err
+}
+
+// DecodeLines reads one JSON object from r and records the line of each value.
+func (JSONDecoder) DecodeLines(r io.Reader) (map[string]any, map[string]int, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	lines := make(map[string]int)
+	if len(bytes.TrimSpace(data)) == 0 {
+		return map[string]any{}, lines, nil
+	}
+
+	p := jsonLineParser{dec: json.NewDecoder(bytes.NewReader(data)), data: data, lines: lines}
+	p.dec.UseNumber()
+	tok, err := p.dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, nil, fmt.Errorf("expected a JSON object at the top level")
+	}
+	doc, err := p.object("")
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := p.dec.Token(); err != io.EOF {
+		return nil, nil, fmt.Errorf("unexpected data after top-level object")
+	}
+	return doc, lines, nil
+}
+
+// jsonLineParser walks JSON tokens while tracking line numbers.
+type jsonLineParser struct {
+	dec   *json.Decoder
+	data  []byte
+	lines map[string]int
+}
+
+// object reads the members of an object whose opening brace was consumed.
+func (p *jsonLineParser) object(prefix string) (map[string]any, error) {
+	out := make(map[string]any)
+	for p.dec.More() {
+		tok, err := p.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		line := p.line()
+		val, err := p.value(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, nested := val.(map[string]any); !nested {
+			p.lines[path] = line
+		}
+		out[key] = val
+	}
+	_, err := p.dec.Token()
+	return out, err
+}
+
+// value reads one value of any kind.
+func (p *jsonLineParser) value(path string) (any, error) {
+	tok, err := p.dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		return p.object(path)
+	case json.Delim('['):
+		list := []any{}
+		for p.dec.More() {
+			item, err := p.value(path)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+		_, err := p.dec.Token()
+		return list, err
+	}
+	return tok, nil
+}
+
+// line returns the 1-based line of the decoder's current offset.
+func (p *jsonLineParser) line() int {
+	return 1 + bytes.Count(p.data[:p.dec.InputOffset()], []byte{'\n'})
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MapInputConfig extends SliceInputConfig with the key/value separator of map values.
+type MapInputConfig struct {
+	SliceInputConfig
+	Separator  string // Separator between key and value, e.g. "=" or ":"
+	UniqueKeys bool   // Reject keys given more than once
+}
+
+// MapEntry is one raw key/value pair split from map input.
+type MapEntry struct {
+	Key   string
+	Value string
+}
+
+// Entries splits raw input into key/value pairs; empty items are skipped when AllowEmpty is set.
+func (c *MapInputConfig) Entries(raw string) ([]MapEntry, error) {
+	chunks, err := c.Split(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]MapEntry, 0, len(chunks))
+	for _, chunk := range chunks {
+		chunk = c.Normalize(chunk)
+		if chunk == "" {
+			if c.AllowEmpty {
+				continue
+			}
+			return nil, fmt.Errorf("invalid map entry %q: empty values are not allowed", chunk)
+		}
+		key, val, ok := strings.Cut(chunk, c.Separator)
+		if !ok {
+			return nil, fmt.Errorf("invalid map entry %q: expected key%svalue", chunk, c.Separator)
+		}
+		if c.TrimSpace {
+			key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		}
+		entries = append(entries, MapEntry{Key: key, Value: val})
+	}
+	return entries, nil
+}
+
+// MapHooks parses and formats typed map entries.
+type MapHooks[K comparable, V any] struct {
+	Keys     ValueHooks[K]
+	Values   ValueHooks[V]
+	Validate func(K, V) error
+}
+
+// NewMapHooks returns hooks for typed keys and values.
+func NewMapHooks[K comparable, V any](
+	parseKey func(string) (K, error),
+	formatKey func(K) string,
+	parseValue func(string) (V, error),
+	formatValue func(V) string,
+) MapHooks[K, V] {
+	return MapHooks[K, V]{
+		Keys:   NewValueHooks(parseKey, formatKey),
+		Values: NewValueHooks(parseValue, formatValue),
+	}
+}
+
+// Merge parses the entries of raw and adds them to dst.
+func (h *MapHooks[K, V]) Merge(dst map[K]V, raw string, input *MapInputConfig) error {
+	entries, err := input.Entries(raw)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		key, err := h.Keys.ParseValue(entry.Key)
+		if err != nil {
+			return fmt.Errorf("invalid key %q: %w", entry.Key, err)
+		}
+		val, err := h.Values.ParseValue(entry.Value)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for key %q: %w", entry.Value, entry.Key, err)
+		}
+		if h.Validate != nil {
+			if err := h.Validate(key, val); err != nil {
+				return fmt.Errorf("invalid entry %q: %w", entry.Key+input.Separator+entry.Value, err)
+			}
+		}
+		if _, dup := dst[key]; dup && input.UniqueKeys {
+			return fmt.Errorf("duplicate key %q", entry.Key)
+		}
+		dst[key] = val
+	}
+	return nil
+}
+
+// Format renders m as "key<sep>value" items sorted by formatted key.
+func (h *MapHooks[K, V]) Format(m map[K]V, sep string) []string {
+	entries := make([]MapEntry, 0, len(m))
+	for k, v := range m {
+		entries = append(entries, MapEntry{Key: h.Keys.Format(k), Value: h.Values.Format(v)})
+	}
+	slices.SortFunc(entries, func(a, b MapEntry) int { return strings.Compare(a.Key, b.Key) })
+
+	out := make([]string, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, e.Key+sep+e.Value)
+	}
+	return out
+}
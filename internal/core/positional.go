@@ -0,0 +1,26 @@
+package core
+
+// PositionalArg is a named positional argument filled from the arguments left after flag parsing.
+type PositionalArg interface {
+	Name() string              // Display name, e.g. "env".
+	Usage() string             // Help description.
+	Arity() (min, max int)     // Number of values consumed; max is -1 when unlimited.
+	Set(values []string) error // Parse, validate and store the assigned values.
+	Changed() bool             // Whether any value was assigned.
+	Default() string           // Formatted default, or "" when there is none.
+	AllowedValues() []string   // Allowed values shown in help.
+	ResetParseState()          // Restore the default before a new parse.
+}
+
+// PositionalToken renders an argument for usage lines: <name>, [name], <name...> or [name...].
+func PositionalToken(arg PositionalArg) string {
+	minVals, maxVals := arg.Arity()
+	token := arg.Name()
+	if maxVals != 1 {
+		token += "..."
+	}
+	if minVals == 0 {
+		return "[" + token + "]"
+	}
+	return "<" + token + ">"
+}
@@ -1,23 +1,117 @@
 package core
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
 // SliceInputConfig centralizes delimiter and item normalization for slice values.
 type SliceInputConfig struct {
 	Delimiter  string
 	AllowEmpty bool
 	TrimSpace  bool
+	Quoted     bool // Honor double quotes and backslash escapes when splitting
 }
 
 // Split breaks a raw slice input into chunks using the configured delimiter.
+// An empty delimiter keeps the input as a single chunk.
 func (c *SliceInputConfig) Split(raw string) ([]string, error) {
+	if c.Quoted {
+		return c.splitQuoted(raw)
+	}
+	if c.Delimiter == "" {
+		return []string{raw}, nil
+	}
 	return strings.Split(raw, c.Delimiter), nil
 }
 
 // Normalize prepares one split item before parsing.
+// Quoted input is already trimmed during splitting, outside of quotes only.
 func (c *SliceInputConfig) Normalize(raw string) string {
-	if c.TrimSpace {
+	if c.TrimSpace && !c.Quoted {
 		return strings.TrimSpace(raw)
 	}
 	return raw
 }
+
+// QuoteItem renders one item so that quoted splitting yields it back unchanged.
+func (c *SliceInputConfig) QuoteItem(item string) string {
+	if !c.Quoted {
+		return item
+	}
+	needsQuotes := strings.ContainsAny(item, `"\`) ||
+		(c.Delimiter != "" && strings.Contains(item, c.Delimiter)) ||
+		strings.TrimSpace(item) != item
+	if !needsQuotes {
+		return item
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(item) + `"`
+}
+
+// Join quotes items as needed and joins them with the delimiter, the inverse of Split.
+func (c *SliceInputConfig) Join(items []string) string {
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = c.QuoteItem(item)
+	}
+	return strings.Join(out, c.Delimiter)
+}
+
+// splitQuoted splits raw on delimiters outside double quotes, removing the quotes and
+// resolving backslash escapes. With TrimSpace, only whitespace outside quotes is trimmed.
+func (c *SliceInputConfig) splitQuoted(raw string) ([]string, error) {
+	var (
+		parts    []string
+		cur      strings.Builder
+		inQuotes bool
+		started  bool // Item has content, so leading whitespace is kept
+		kept     int  // Length of cur that trailing trimming must not touch
+	)
+	finish := func() {
+		item := cur.String()
+		if c.TrimSpace {
+			item = item[:kept] + strings.TrimRightFunc(item[kept:], unicode.IsSpace)
+		}
+		parts = append(parts, item)
+		cur.Reset()
+		started, kept = false, 0
+	}
+
+	for i := 0; i < len(raw); {
+		switch {
+		case raw[i] == '\\':
+			if i+1 >= len(raw) {
+				return nil, fmt.Errorf("invalid value %q: trailing backslash", raw)
+			}
+			r, size := utf8.DecodeRuneInString(raw[i+1:])
+			cur.WriteRune(r)
+			started, kept = true, cur.Len()
+			i += 1 + size
+		case raw[i] == '"':
+			inQuotes = !inQuotes
+			started, kept = true, cur.Len()
+			i++
+		case !inQuotes && c.Delimiter != "" && strings.HasPrefix(raw[i:], c.Delimiter):
+			finish()
+			i += len(c.Delimiter)
+		default:
+			r, size := utf8.DecodeRuneInString(raw[i:])
+			i += size
+			if c.TrimSpace && !inQuotes && !started && unicode.IsSpace(r) {
+				continue
+			}
+			cur.WriteRune(r)
+			started = true
+			if inQuotes {
+				kept = cur.Len()
+			}
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("invalid value %q: unterminated quote", raw)
+	}
+	finish()
+	return parts, nil
+}
@@ -0,0 +1,102 @@
+package core
+
+import "fmt"
+
+// SourceKind identifies where a flag value came from.
+type SourceKind int
+
+const (
+	SourceDefault   SourceKind = iota // Registered default, untouched.
+	SourceCLI                         // Command-line argument.
+	SourceEnv                         // Environment variable.
+	SourceConfig                      // Config file entry.
+	SourceFinalizer                   // Default rewritten by a default-value finalizer.
+)
+
+// String returns the lower-case name of the source kind.
+func (k SourceKind) String() string {
+	switch k {
+	case SourceCLI:
+		return "cli"
+	case SourceEnv:
+		return "env"
+	case SourceConfig:
+		return "config"
+	case SourceFinalizer:
+		return "finalizer"
+	default:
+		return "default"
+	}
+}
+
+// Source records where a flag value came from during the last parse.
+type Source struct {
+	Kind  SourceKind // Origin of the value.
+	Key   string     // Environment variable or config key that supplied the value.
+	File  string     // Config file path.
+	Line  int        // Config file line, when the decoder reports it.
+	Value any        // Effective value after MaskFn, when included.
+}
+
+// String describes the source, e.g. "env APP_TIMEOUT" or "config app.json:12".
+func (s Source) String() string {
+	switch {
+	case s.Kind == SourceEnv && s.Key != "":
+		return "env " + s.Key
+	case s.Kind == SourceConfig && s.File != "" && s.Line > 0:
+		return fmt.Sprintf("config %s:%d", s.File, s.Line)
+	case s.Kind == SourceConfig && s.File != "":
+		return "config " + s.File
+	case s.Kind == SourceConfig && s.Key != "":
+		return "config " + s.Key
+	default:
+		return s.Kind.String()
+	}
+}
+
+// DefaultFinalizeReporter reports whether a default-value finalizer rewrote the value.
+type DefaultFinalizeReporter interface {
+	DefaultFinalizeApplied() bool
+}
+
+// RecordSource stores the source of the static value (id "") or of one dynamic instance.
+func (f *BaseFlag) RecordSource(id string, src Source) {
+	if id == "" {
+		f.source = src
+		return
+	}
+	if f.instanceSources == nil {
+		f.instanceSources = make(map[string]Source)
+	}
+	f.instanceSources[id] = src
+}
+
+// ResetSources forgets all sources recorded by the previous parse.
+func (f *BaseFlag) ResetSources() {
+	f.source = Source{}
+	f.instanceSources = nil
+}
+
+// Source returns the static value source including the masked current value.
+func (f *BaseFlag) Source() Source {
+	src := f.source
+	if f.Value != nil {
+		src.Value = f.maskValue(f.Value.Get())
+	}
+	return src
+}
+
+// InstanceSource returns the source of a dynamic instance value including the masked value.
+func (f *BaseFlag) InstanceSource(id string, value any) (Source, bool) {
+	src, ok := f.instanceSources[id]
+	src.Value = f.maskValue(value)
+	return src, ok
+}
+
+// maskValue applies MaskFn when set.
+func (f *BaseFlag) maskValue(v any) any {
+	if f.MaskFn != nil {
+		return f.MaskFn(v)
+	}
+	return v
+}
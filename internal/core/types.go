@@ -66,3 +66,33 @@ type Incrementable interface {
 	Value
 	Increment() error
 }
+
+// ItemFormatter renders the current value as the items typed on the command line.
+type ItemFormatter interface {
+	FormatItems() []string
+}
+
+// DynamicItemFormatter renders the value of one dynamic instance as command-line items.
+type DynamicItemFormatter interface {
+	FormatItems(id string) []string
+}
+
+// ItemDelimiter reports the separator that joins slice items into one string.
+type ItemDelimiter interface {
+	ItemDelimiter() string
+}
+
+// MapSeparator reports the separator between keys and values of map flags.
+type MapSeparator interface {
+	MapSeparator() string
+}
+
+// ItemQuoter quotes items that would otherwise be split apart when parsed back.
+type ItemQuoter interface {
+	QuoteItem(item string) string
+}
+
+// TypeNamer reports the Go type a value parses into, for generated documentation.
+type TypeNamer interface {
+	TypeName() string
+}
@@ -0,0 +1,107 @@
+// Package docs renders reference documentation for command trees.
+package docs
+
+import "strings"
+
+// Page describes one command of a documented command tree.
+type Page struct {
+	Name        string       // Command segment name.
+	Aliases     []string     // Alternative command names.
+	Path        string       // Full command path, starting with the program name.
+	Summary     string       // One-line summary.
+	Deprecated  string       // Deprecation notice; empty for current commands.
+	Synopsis    string       // Usage suffix after the command path (e.g. "[flags] <command>").
+	Description string       // Text shown before the flags.
+	Note        string       // Text shown after the flags.
+	Authors     string       // Authors block.
+	Version     string       // Program version, if any.
+	Flags       []Flag       // Flags defined on this command.
+	Inherited   []Flag       // Global flags inherited from parent commands.
+	Groups      []Group      // Visible dynamic groups.
+	Constraints []Constraint // Visible one-of and all-or-none groups.
+	Parent      *Page        // Parent command; nil for the root.
+	Commands    []*Page      // Visible child commands.
+}
+
+// Flag describes one documented flag.
+type Flag struct {
+	Name        string   // Long name without dashes.
+	Short       string   // Short alias without dash.
+	Placeholder string   // Value placeholder; empty for switches.
+	Usage       string   // Flag description.
+	Type        string   // Go type of the value (e.g. "int", "[]string"); "counter" for counters.
+	Default     string   // Default value; empty when hidden or unset.
+	EnvKey      string   // Environment key; empty when hidden or disabled.
+	Allowed     []string // Allowed values.
+	Requires    []string // Flags this flag requires.
+	Deprecated  string   // Deprecation notice.
+	Required    bool     // Whether the flag must be set.
+}
+
+// Group describes a dynamic flag group.
+type Group struct {
+	Name        string // Group name used in --name.<ID>.field.
+	Title       string // Optional heading.
+	Description string // Text shown before the fields.
+	Note        string // Text shown after the fields.
+	Placeholder string // Placeholder for the instance ID.
+	Flags       []Flag // Visible fields.
+}
+
+// ConstraintKind names the rule a Constraint enforces.
+type ConstraintKind string
+
+const (
+	OneOf     ConstraintKind = "one of"      // At most one member may be set
+	AllOrNone ConstraintKind = "all or none" // Either every member or none is set
+)
+
+// Constraint describes a one-of or all-or-none group.
+type Constraint struct {
+	Kind     ConstraintKind // Rule enforced by the group.
+	Title    string         // Title, or the group name when no title is set.
+	Required bool           // Whether at least one member must be set.
+	Members  []string       // Dashed flag names; nested groups are joined with " + ".
+}
+
+// Label returns the group-qualified flag spelling, e.g. --http.<ID>.port.
+func (g Group) Label(fl Flag) string {
+	return "--" + g.Name + "." + g.Placeholder + "." + fl.Name
+}
+
+// Rule describes the constraint, e.g. "one of, required".
+func (c Constraint) Rule() string {
+	if c.Required {
+		return string(c.Kind) + ", required"
+	}
+	return string(c.Kind)
+}
+
+// DeprecatedSuffix returns the " (deprecated: ...)" marker appended to the summary in command listings.
+func (p *Page) DeprecatedSuffix() string {
+	if p.Deprecated == "" {
+		return ""
+	}
+	return " (deprecated: " + p.Deprecated + ")"
+}
+
+// FileName returns the page path with spaces replaced by dashes (e.g. "app-serve").
+func (p *Page) FileName() string {
+	return strings.ReplaceAll(p.Path, " ", "-")
+}
+
+// Walk calls fn for p and every descendant in depth-first order.
+func (p *Page) Walk(fn func(*Page)) {
+	fn(p)
+	for _, child := range p.Commands {
+		child.Walk(fn)
+	}
+}
+
+// Root returns the top-level page of the tree containing p.
+func (p *Page) Root() *Page {
+	for p.Parent != nil {
+		p = p.Parent
+	}
+	return p
+}
@@ -0,0 +1,207 @@
+package docs
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// htmlStyle keeps the generated page readable without external assets.
+const htmlStyle = `body{font-family:system-ui,sans-serif;max-width:72rem;margin:2rem auto;padding:0 1rem;color:#222;line-height:1.5}
+nav ul{list-style:none;padding-left:1rem}
+section{border-top:1px solid #ddd;margin-top:2rem}
+table{border-collapse:collapse;width:100%;margin:1rem 0}
+th,td{border:1px solid #ddd;padding:.3rem .5rem;text-align:left;vertical-align:top}
+th{background:#f5f5f5}
+code,pre{font-family:ui-monospace,monospace;background:#f5f5f5}
+pre{padding:.5rem}
+.summary{font-size:1.1rem}`
+
+// HTML writes a self-contained HTML reference for root and every descendant.
+// Each command is a section whose id is its FileName; cross-links are in-page anchors.
+func HTML(w io.Writer, root *Page) error {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&b, "<title>%s reference</title>\n", html.EscapeString(root.Path)) // nolint:errcheck
+	b.WriteString("<style>\n" + htmlStyle + "\n</style>\n</head>\n<body>\n")
+
+	b.WriteString("<nav>\n")
+	writeHTMLNav(&b, root)
+	b.WriteString("</nav>\n")
+
+	root.Walk(func(p *Page) {
+		writeHTMLPage(&b, p)
+	})
+
+	b.WriteString("</body>\n</html>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeHTMLNav renders the nested table of contents.
+func writeHTMLNav(b *strings.Builder, p *Page) {
+	fmt.Fprintf(b, "<ul>\n<li>%s\n", htmlLink(p.Path, p)) // nolint:errcheck
+	for _, child := range p.Commands {
+		writeHTMLNav(b, child)
+	}
+	b.WriteString("</li>\n</ul>\n")
+}
+
+// writeHTMLPage renders the section of one command.
+func writeHTMLPage(b *strings.Builder, p *Page) {
+	fmt.Fprintf(b, "<section id=\"%s\">\n", html.EscapeString(p.FileName())) // nolint:errcheck
+	fmt.Fprintf(b, "<h2>%s</h2>\n", html.EscapeString(p.Path))               // nolint:errcheck
+	if p.Summary != "" {
+		fmt.Fprintf(b, "<p class=\"summary\">%s</p>\n", htmlText(p.Summary)) // nolint:errcheck
+	}
+	if p.Deprecated != "" {
+		fmt.Fprintf(b, "<p class=\"deprecated\"><strong>Deprecated:</strong> %s</p>\n", htmlText(p.Deprecated)) // nolint:errcheck
+	}
+	if len(p.Aliases) > 0 {
+		fmt.Fprintf(b, "<p>Aliases: %s</p>\n", htmlCodeList(p.Aliases)) // nolint:errcheck
+	}
+	if p.Parent != nil {
+		fmt.Fprintf(b, "<p>Parent: %s</p>\n", htmlLink(p.Parent.Path, p.Parent)) // nolint:errcheck
+	}
+	fmt.Fprintf(b, "<pre><code>%s</code></pre>\n", html.EscapeString(strings.TrimSpace(p.Path+" "+p.Synopsis))) // nolint:errcheck
+	writeHTMLParagraphs(b, p.Description)
+
+	writeHTMLFlags(b, "Flags", p.Flags)
+	writeHTMLFlags(b, "Global Flags", p.Inherited)
+
+	if len(p.Groups) > 0 {
+		b.WriteString("<h3>Dynamic Groups</h3>\n")
+	}
+	for _, group := range p.Groups {
+		title := group.Title
+		if title == "" {
+			title = group.Name
+		}
+		fmt.Fprintf(b, "<h4>%s</h4>\n", html.EscapeString(title))                                                                // nolint:errcheck
+		fmt.Fprintf(b, "<p>Pattern: <code>%s</code></p>\n", html.EscapeString("--"+group.Name+"."+group.Placeholder+".<field>")) // nolint:errcheck
+		writeHTMLParagraphs(b, group.Description)
+		rows := make([][]string, 0, len(group.Flags))
+		for _, fl := range group.Flags {
+			rows = append(rows, append([]string{htmlCode(group.Label(fl) + placeholderSuffix(fl))}, htmlFlagCells(fl)[1:]...))
+		}
+		writeHTMLTable(b, append([]string{"Flag"}, flagColumns[1:]...), rows)
+		writeHTMLParagraphs(b, group.Note)
+	}
+
+	if len(p.Constraints) > 0 {
+		b.WriteString("<h3>Flag Groups</h3>\n")
+		rows := make([][]string, 0, len(p.Constraints))
+		for _, c := range p.Constraints {
+			rows = append(rows, []string{htmlText(c.Title), html.EscapeString(c.Rule()), htmlCodeList(c.Members)})
+		}
+		writeHTMLTable(b, []string{"Group", "Rule", "Flags"}, rows)
+	}
+
+	if len(p.Commands) > 0 {
+		b.WriteString("<h3>Commands</h3>\n")
+		rows := make([][]string, 0, len(p.Commands))
+		for _, child := range p.Commands {
+			rows = append(rows, []string{htmlLink(child.Name, child), htmlText(firstLine(child.Summary) + child.DeprecatedSuffix())})
+		}
+		writeHTMLTable(b, []string{"Command", "Summary"}, rows)
+	}
+
+	if p.Note != "" {
+		b.WriteString("<h3>Notes</h3>\n")
+		writeHTMLParagraphs(b, p.Note)
+	}
+	if p.Authors != "" {
+		b.WriteString("<h3>Authors</h3>\n")
+		writeHTMLParagraphs(b, p.Authors)
+	}
+	b.WriteString("</section>\n")
+}
+
+// writeHTMLFlags renders a flag table with a heading.
+func writeHTMLFlags(b *strings.Builder, title string, flags []Flag) {
+	if len(flags) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "<h3>%s</h3>\n", title) // nolint:errcheck
+	rows := make([][]string, 0, len(flags))
+	for _, fl := range flags {
+		rows = append(rows, append([]string{htmlCode("--" + fl.Name + placeholderSuffix(fl))}, htmlFlagCells(fl)...))
+	}
+	writeHTMLTable(b, append([]string{"Flag"}, flagColumns...), rows)
+}
+
+// htmlFlagCells returns the HTML cells of fl matching flagColumns.
+func htmlFlagCells(fl Flag) []string {
+	short := ""
+	if fl.Short != "" {
+		short = htmlCode("-" + fl.Short)
+	}
+	required := ""
+	if fl.Required {
+		required = "yes"
+	}
+	return []string{
+		short,
+		htmlCode(fl.Type),
+		htmlText(fl.Usage + requiresSuffix(fl)),
+		htmlCode(fl.Default),
+		htmlCode(fl.EnvKey),
+		required,
+		htmlText(fl.Deprecated),
+		htmlCodeList(fl.Allowed),
+	}
+}
+
+// writeHTMLTable renders a table; cells are already escaped.
+func writeHTMLTable(b *strings.Builder, header []string, rows [][]string) {
+	b.WriteString("<table>\n<thead><tr>")
+	for _, h := range header {
+		b.WriteString("<th>" + html.EscapeString(h) + "</th>")
+	}
+	b.WriteString("</tr></thead>\n<tbody>\n")
+	for _, row := range rows {
+		b.WriteString("<tr>")
+		for _, cell := range row {
+			b.WriteString("<td>" + cell + "</td>")
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+}
+
+// writeHTMLParagraphs renders text split on blank lines.
+func writeHTMLParagraphs(b *strings.Builder, text string) {
+	for _, para := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		if para = strings.TrimSpace(para); para != "" {
+			b.WriteString("<p>" + htmlText(para) + "</p>\n")
+		}
+	}
+}
+
+// htmlText escapes s and keeps line breaks.
+func htmlText(s string) string {
+	return strings.ReplaceAll(html.EscapeString(strings.TrimSpace(s)), "\n", "<br>")
+}
+
+// htmlCode renders s as inline code; empty values stay empty.
+func htmlCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "<code>" + html.EscapeString(s) + "</code>"
+}
+
+// htmlCodeList renders values as comma-separated inline code.
+func htmlCodeList(values []string) string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = htmlCode(v)
+	}
+	return strings.Join(out, ", ")
+}
+
+// htmlLink links to the section of p.
+func htmlLink(text string, p *Page) string {
+	return "<a href=\"#" + html.EscapeString(p.FileName()) + "\">" + html.EscapeString(text) + "</a>"
+}
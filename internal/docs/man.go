@@ -0,0 +1,232 @@
+package docs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ManHeader holds the .TH fields of a man page.
+type ManHeader struct {
+	Section string    // Manual section; defaults to "1".
+	Date    time.Time // Date shown in the footer; omitted when zero.
+	Source  string    // Footer source; defaults to the program name and version.
+	Manual  string    // Manual title shown in the header (e.g. "User Commands").
+}
+
+// Man writes the roff man page for p.
+func Man(w io.Writer, p *Page, header ManHeader) error {
+	var b strings.Builder
+
+	section := header.Section
+	if section == "" {
+		section = "1"
+	}
+	date := ""
+	if !header.Date.IsZero() {
+		date = header.Date.Format("2006-01-02")
+	}
+	source := header.Source
+	if source == "" {
+		root := p.Root()
+		source = strings.TrimSpace(root.Name + " " + root.Version)
+	}
+	fmt.Fprintf(&b, ".TH %s %s %s %s %s\n", // nolint:errcheck
+		manQuote(strings.ToUpper(p.FileName())), manQuote(section), manQuote(date), manQuote(source), manQuote(header.Manual))
+
+	b.WriteString(".SH NAME\n")
+	b.WriteString(manEscape(p.FileName()))
+	if summary := firstLine(p.Summary); summary != "" {
+		b.WriteString(` \- ` + manEscape(summary))
+	}
+	b.WriteString("\n")
+
+	b.WriteString(".SH SYNOPSIS\n")
+	fmt.Fprintf(&b, ".B %s\n", manQuote(p.Path)) // nolint:errcheck
+	if p.Synopsis != "" {
+		b.WriteString(manEscape(p.Synopsis) + "\n")
+	}
+
+	if len(p.Aliases) > 0 {
+		b.WriteString(".SH ALIASES\n")
+		b.WriteString(manEscape(strings.Join(p.Aliases, ", ")) + "\n")
+	}
+	if p.Deprecated != "" {
+		b.WriteString(".SH DEPRECATED\n")
+		writeManText(&b, p.Deprecated)
+	}
+
+	if p.Description != "" {
+		b.WriteString(".SH DESCRIPTION\n")
+		writeManText(&b, p.Description)
+	}
+
+	writeManFlags(&b, "OPTIONS", p.Flags)
+	writeManFlags(&b, "GLOBAL OPTIONS", p.Inherited)
+
+	if len(p.Groups) > 0 {
+		b.WriteString(".SH \"DYNAMIC OPTIONS\"\n")
+		for _, group := range p.Groups {
+			title := group.Title
+			if title == "" {
+				title = "--" + group.Name + "." + group.Placeholder + ".*"
+			}
+			fmt.Fprintf(&b, ".SS %s\n", manQuote(title)) // nolint:errcheck
+			if group.Description != "" {
+				writeManText(&b, group.Description)
+			}
+			for _, fl := range group.Flags {
+				writeManFlag(&b, manBold(group.Label(fl)), fl)
+			}
+			if group.Note != "" {
+				b.WriteString(".PP\n")
+				writeManText(&b, group.Note)
+			}
+		}
+	}
+
+	if len(p.Constraints) > 0 {
+		b.WriteString(".SH \"FLAG GROUPS\"\n")
+		for _, c := range p.Constraints {
+			b.WriteString(".TP\n")
+			fmt.Fprintf(&b, "%s (%s)\n", manBold(c.Title), c.Rule()) // nolint:errcheck
+			b.WriteString(manEscape(strings.Join(c.Members, ", ")) + "\n")
+		}
+	}
+
+	if len(p.Commands) > 0 {
+		b.WriteString(".SH COMMANDS\n")
+		for _, child := range p.Commands {
+			b.WriteString(".TP\n")
+			b.WriteString(manBold(child.Name) + "\n")
+			if summary := child.Summary + child.DeprecatedSuffix(); summary != "" {
+				writeManText(&b, summary)
+			}
+		}
+	}
+
+	if p.Note != "" {
+		b.WriteString(".SH NOTES\n")
+		writeManText(&b, p.Note)
+	}
+	if p.Authors != "" {
+		b.WriteString(".SH AUTHORS\n")
+		writeManText(&b, p.Authors)
+	}
+
+	var related []string
+	if p.Parent != nil {
+		related = append(related, manBold(p.Parent.FileName())+"("+section+")")
+	}
+	for _, child := range p.Commands {
+		related = append(related, manBold(child.FileName())+"("+section+")")
+	}
+	if len(related) > 0 {
+		b.WriteString(".SH \"SEE ALSO\"\n")
+		b.WriteString(strings.Join(related, ", ") + "\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeManFlags renders a section of tagged flag paragraphs.
+func writeManFlags(b *strings.Builder, title string, flags []Flag) {
+	if len(flags) == 0 {
+		return
+	}
+	fmt.Fprintf(b, ".SH %s\n", manQuote(title)) // nolint:errcheck
+	for _, fl := range flags {
+		label := manBold("--" + fl.Name)
+		if fl.Short != "" {
+			label = manBold("-"+fl.Short) + ", " + label
+		}
+		writeManFlag(b, label, fl)
+	}
+}
+
+// writeManFlag renders one tagged paragraph with the flag's usage and metadata lines.
+func writeManFlag(b *strings.Builder, label string, fl Flag) {
+	b.WriteString(".TP\n")
+	if fl.Placeholder != "" {
+		label += " " + `\fI` + manEscape(fl.Placeholder) + `\fR`
+	}
+	b.WriteString(label + "\n")
+	if fl.Usage != "" {
+		writeManText(b, fl.Usage)
+	}
+
+	var details []string
+	if fl.Deprecated != "" {
+		details = append(details, "Deprecated: "+manEscape(fl.Deprecated))
+	}
+	if len(fl.Allowed) > 0 {
+		details = append(details, "Allowed: "+manEscape(strings.Join(fl.Allowed, ", ")))
+	}
+	if fl.Default != "" {
+		details = append(details, "Default: "+manEscape(fl.Default))
+	}
+	if fl.EnvKey != "" {
+		details = append(details, "Environment: "+manBold(fl.EnvKey))
+	}
+	if len(fl.Requires) > 0 {
+		requires := make([]string, len(fl.Requires))
+		for i, name := range fl.Requires {
+			requires[i] = manBold("--" + name)
+		}
+		details = append(details, "Requires: "+strings.Join(requires, ", "))
+	}
+	if fl.Required {
+		details = append(details, "Required.")
+	}
+	for i, detail := range details {
+		if i > 0 || fl.Usage != "" {
+			b.WriteString(".br\n")
+		}
+		b.WriteString(detail + "\n")
+	}
+}
+
+// writeManText writes free text, turning blank lines into paragraph breaks.
+func writeManText(b *strings.Builder, text string) {
+	blank := false
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			blank = true
+			continue
+		}
+		if blank {
+			b.WriteString(".PP\n")
+			blank = false
+		}
+		b.WriteString(manEscape(line) + "\n")
+	}
+}
+
+// manEscape escapes roff control characters and keeps hyphens literal.
+func manEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\e`)
+	s = strings.ReplaceAll(s, "-", `\-`)
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+		s = `\&` + s
+	}
+	return s
+}
+
+// manBold renders s in bold.
+func manBold(s string) string {
+	return `\fB` + manEscape(s) + `\fR`
+}
+
+// manQuote escapes s and wraps it in double quotes for a macro argument.
+func manQuote(s string) string {
+	return `"` + strings.ReplaceAll(manEscape(s), `"`, `\(dq`) + `"`
+}
+
+// firstLine returns the first line of s.
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return strings.TrimSpace(line)
+}
@@ -0,0 +1,210 @@
+package docs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarkdownFile returns the file name of p's Markdown page, used for cross-links.
+func MarkdownFile(p *Page) string {
+	return p.FileName() + ".md"
+}
+
+// Markdown writes the Markdown reference page for p; link maps a page to its URL.
+func Markdown(w io.Writer, p *Page, link func(*Page) string) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", p.Path) // nolint:errcheck
+	if p.Summary != "" {
+		b.WriteString(p.Summary + "\n\n")
+	}
+	if p.Deprecated != "" {
+		b.WriteString("**Deprecated:** " + mdText(p.Deprecated) + "\n\n")
+	}
+	if len(p.Aliases) > 0 {
+		b.WriteString("Aliases: " + mdCodeList(p.Aliases) + "\n\n")
+	}
+
+	b.WriteString("## Synopsis\n\n```\n")
+	b.WriteString(strings.TrimSpace(p.Path+" "+p.Synopsis) + "\n")
+	b.WriteString("```\n\n")
+
+	if p.Description != "" {
+		b.WriteString(strings.TrimSpace(p.Description) + "\n\n")
+	}
+
+	writeMarkdownFlags(&b, "Flags", p.Flags)
+	writeMarkdownFlags(&b, "Global Flags", p.Inherited)
+
+	if len(p.Groups) > 0 {
+		b.WriteString("## Dynamic Groups\n\n")
+		for _, group := range p.Groups {
+			title := group.Title
+			if title == "" {
+				title = group.Name
+			}
+			fmt.Fprintf(&b, "### %s\n\n", title)                                             // nolint:errcheck
+			fmt.Fprintf(&b, "Pattern: `--%s.%s.<field>`\n\n", group.Name, group.Placeholder) // nolint:errcheck
+			if group.Description != "" {
+				b.WriteString(strings.TrimSpace(group.Description) + "\n\n")
+			}
+			rows := make([][]string, 0, len(group.Flags))
+			for _, fl := range group.Flags {
+				rows = append(rows, append([]string{mdCode(group.Label(fl) + placeholderSuffix(fl))}, flagCells(fl)[1:]...))
+			}
+			writeMarkdownTable(&b, append([]string{"Flag"}, flagColumns[1:]...), rows)
+			if group.Note != "" {
+				b.WriteString(strings.TrimSpace(group.Note) + "\n\n")
+			}
+		}
+	}
+
+	if len(p.Constraints) > 0 {
+		b.WriteString("## Flag Groups\n\n")
+		rows := make([][]string, 0, len(p.Constraints))
+		for _, c := range p.Constraints {
+			rows = append(rows, []string{mdText(c.Title), c.Rule(), mdCodeList(c.Members)})
+		}
+		writeMarkdownTable(&b, []string{"Group", "Rule", "Flags"}, rows)
+	}
+
+	if len(p.Commands) > 0 {
+		b.WriteString("## Commands\n\n")
+		rows := make([][]string, 0, len(p.Commands))
+		for _, child := range p.Commands {
+			rows = append(rows, []string{mdLink(child.Name, link(child)), mdText(firstLine(child.Summary) + child.DeprecatedSuffix())})
+		}
+		writeMarkdownTable(&b, []string{"Command", "Summary"}, rows)
+	}
+
+	if p.Note != "" {
+		b.WriteString("## Notes\n\n" + strings.TrimSpace(p.Note) + "\n\n")
+	}
+	if p.Authors != "" {
+		b.WriteString("## Authors\n\n" + strings.TrimSpace(p.Authors) + "\n\n")
+	}
+
+	if p.Parent != nil || len(p.Commands) > 0 {
+		b.WriteString("## See Also\n\n")
+		if p.Parent != nil {
+			writeMarkdownSeeAlso(&b, p.Parent, link)
+		}
+		for _, child := range p.Commands {
+			writeMarkdownSeeAlso(&b, child, link)
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, strings.TrimRight(b.String(), "\n")+"\n")
+	return err
+}
+
+// flagColumns are the flag table headings after the flag name; dynamic tables skip Short.
+var flagColumns = []string{"Short", "Type", "Description", "Default", "Env", "Required", "Deprecated", "Allowed"}
+
+// writeMarkdownFlags renders a flag table section.
+func writeMarkdownFlags(b *strings.Builder, title string, flags []Flag) {
+	if len(flags) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "## %s\n\n", title) // nolint:errcheck
+	rows := make([][]string, 0, len(flags))
+	for _, fl := range flags {
+		rows = append(rows, append([]string{mdCode("--" + fl.Name + placeholderSuffix(fl))}, flagCells(fl)...))
+	}
+	writeMarkdownTable(b, append([]string{"Flag"}, flagColumns...), rows)
+}
+
+// flagCells returns the Markdown cells of fl matching flagColumns.
+func flagCells(fl Flag) []string {
+	short := ""
+	if fl.Short != "" {
+		short = mdCode("-" + fl.Short)
+	}
+	required := ""
+	if fl.Required {
+		required = "yes"
+	}
+	return []string{
+		short,
+		mdCode(fl.Type),
+		mdText(fl.Usage + requiresSuffix(fl)),
+		mdCode(fl.Default),
+		mdCode(fl.EnvKey),
+		required,
+		mdText(fl.Deprecated),
+		mdCodeList(fl.Allowed),
+	}
+}
+
+// writeMarkdownTable renders a GitHub-flavored Markdown table.
+func writeMarkdownTable(b *strings.Builder, header []string, rows [][]string) {
+	b.WriteString("| " + strings.Join(header, " | ") + " |\n")
+	b.WriteString("|" + strings.Repeat(" :--- |", len(header)) + "\n")
+	for _, row := range rows {
+		b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+	}
+	b.WriteString("\n")
+}
+
+// writeMarkdownSeeAlso renders one related-page bullet.
+func writeMarkdownSeeAlso(b *strings.Builder, p *Page, link func(*Page) string) {
+	b.WriteString("- " + mdLink(p.Path, link(p)))
+	if summary := firstLine(p.Summary); summary != "" {
+		b.WriteString(" - " + mdText(summary))
+	}
+	b.WriteString("\n")
+}
+
+// placeholderSuffix returns " PLACEHOLDER" for flags that take a value.
+func placeholderSuffix(fl Flag) string {
+	if fl.Placeholder == "" {
+		return ""
+	}
+	return " " + fl.Placeholder
+}
+
+// requiresSuffix describes the flags fl requires.
+func requiresSuffix(fl Flag) string {
+	if len(fl.Requires) == 0 {
+		return ""
+	}
+	return " (requires: --" + strings.Join(fl.Requires, ", --") + ")"
+}
+
+// mdText escapes s for a table cell.
+func mdText(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(strings.TrimSpace(s), "\n", "<br>")
+}
+
+// mdCode renders s as inline code; empty values stay empty.
+func mdCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	s = mdText(s)
+	fence := "`"
+	for strings.Contains(s, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		s = " " + s + " "
+	}
+	return fence + s + fence
+}
+
+// mdCodeList renders values as comma-separated inline code.
+func mdCodeList(values []string) string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = mdCode(v)
+	}
+	return strings.Join(out, ", ")
+}
+
+// mdLink renders a Markdown link.
+func mdLink(text, url string) string {
+	return "[" + strings.NewReplacer("[", `\[`, "]", `\]`).Replace(text) + "](" + url + ")"
+}
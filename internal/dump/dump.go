@@ -0,0 +1,146 @@
+// Package dump renders resolved flag values as JSON, dotenv or command-line arguments.
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Format names an output format.
+type Format string
+
+const (
+	JSON   Format = "json"   // Nested JSON object, readable by ConfigFile
+	Dotenv Format = "dotenv" // KEY=value lines using the env keys
+	Args   Format = "args"   // Shell-quoted arguments that round-trip through Parse
+)
+
+// Entry is one resolved flag value.
+type Entry struct {
+	Name     string   // Static flag name, or group.id.field for dynamic entries.
+	Group    string   // Dynamic group name; empty for static flags.
+	ID       string   // Dynamic instance ID.
+	Field    string   // Dynamic field name.
+	EnvKey   string   // Environment key; empty when the flag cannot be read from env.
+	EnvValue string   // Value as the environment loader expects it.
+	JSON     any      // Value as written to JSON.
+	Args     []string // Tokens reproducing the value on the command line; nil when unchanged.
+}
+
+// Section holds the entries of one command; Path lists command names below the root.
+type Section struct {
+	Path    []string
+	Entries []Entry
+}
+
+// Write renders sections in the given format.
+func Write(w io.Writer, format Format, sections []Section) error {
+	switch format {
+	case JSON:
+		return writeJSON(w, sections)
+	case Dotenv:
+		return writeDotenv(w, sections)
+	case Args:
+		return writeArgs(w, sections)
+	default:
+		return fmt.Errorf("unsupported dump format %q (supported: json, dotenv, args)", format)
+	}
+}
+
+// writeJSON nests command sections by name and dynamic entries by group and ID.
+func writeJSON(w io.Writer, sections []Section) error {
+	root := make(map[string]any)
+	for _, section := range sections {
+		node := root
+		for _, name := range section.Path {
+			node = child(node, name)
+		}
+		for _, e := range section.Entries {
+			if e.Group == "" {
+				node[e.Name] = e.JSON
+				continue
+			}
+			child(child(node, e.Group), e.ID)[e.Field] = e.JSON
+		}
+	}
+	data, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// child returns the nested object under key, creating it when missing.
+func child(node map[string]any, key string) map[string]any {
+	next, ok := node[key].(map[string]any)
+	if !ok {
+		next = make(map[string]any)
+		node[key] = next
+	}
+	return next
+}
+
+// writeDotenv writes one KEY=value line per entry that has an env key.
+// Dynamic IDs with upper-case letters are rejected: the env loader lower-cases IDs,
+// so such instances would reload under a different name.
+func writeDotenv(w io.Writer, sections []Section) error {
+	seen := make(map[string]bool)
+	var b strings.Builder
+	for _, section := range sections {
+		for _, e := range section.Entries {
+			if e.EnvKey == "" || seen[e.EnvKey] {
+				continue
+			}
+			if e.ID != strings.ToLower(e.ID) {
+				return fmt.Errorf("cannot dump --%s as dotenv: instance ID %q is not lower-case", e.Name, e.ID)
+			}
+			seen[e.EnvKey] = true
+			fmt.Fprintf(&b, "%s=%s\n", e.EnvKey, dotenvQuote(e.EnvValue))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeArgs writes command names and changed flags as one shell-quoted line.
+func writeArgs(w io.Writer, sections []Section) error {
+	var words []string
+	for _, section := range sections {
+		if n := len(section.Path); n > 0 {
+			words = append(words, shellQuote(section.Path[n-1]))
+		}
+		for _, e := range section.Entries {
+			for _, arg := range e.Args {
+				words = append(words, shellQuote(arg))
+			}
+		}
+	}
+	_, err := io.WriteString(w, strings.Join(words, " ")+"\n")
+	return err
+}
+
+var (
+	shellSafe  = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+	dotenvSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]*$`)
+)
+
+// shellQuote single-quotes s unless it only contains shell-safe characters.
+func shellQuote(s string) string {
+	if shellSafe.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// dotenvQuote double-quotes s unless it only contains safe characters.
+func dotenvQuote(s string) string {
+	if dotenvSafe.MatchString(s) {
+		return s
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`)
+	return `"` + r.Replace(s) + `"`
+}
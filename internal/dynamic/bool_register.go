@@ -36,7 +36,7 @@ func registerDynamicBool(
 
 	// Return wrapped BoolFlag for external access
 	return &BoolFlag{
-		DynamicFlag: builder.NewDynamicFlag[bool](g.fs, bf),
+		DynamicFlag: builder.NewDynamicFlag[bool](g.fs, bf, val),
 		item:        val.Base(),
 		strictMode:  strict,
 	}
@@ -0,0 +1,80 @@
+package dynamic
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/containeroo/tinyflags/internal/utils"
+)
+
+// Instance is one decoded instance of a dynamic group.
+type Instance[T any] struct {
+	ID    string // Instance ID, e.g. "a" in --http.a.port
+	Value T      // Decoded fields
+}
+
+// Decode fills a T per instance ID from the group's fields.
+func Decode[T any](g *Group) (map[string]T, error) {
+	instances, err := DecodeOrdered[T](g)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]T, len(instances))
+	for _, inst := range instances {
+		out[inst.ID] = inst.Value
+	}
+	return out, nil
+}
+
+// DecodeOrdered is like Decode but returns the instances sorted by ID.
+func DecodeOrdered[T any](g *Group) ([]Instance[T], error) {
+	ids := g.Instances()
+	out := make([]Instance[T], 0, len(ids))
+	for _, id := range ids {
+		inst := Instance[T]{ID: id}
+		if err := DecodeInto(g, id, reflect.ValueOf(&inst.Value).Elem()); err != nil {
+			return nil, err
+		}
+		out = append(out, inst)
+	}
+	return out, nil
+}
+
+// DecodeInto sets the fields of the struct dst from the values of instance id.
+// Fields match registered field names via their flag tag or kebab-cased name; flag:"-" skips a field.
+// Unset fields receive the field default.
+func DecodeInto(g *Group, id string, dst reflect.Value) error {
+	if dst.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot decode dynamic group %q into %s: not a struct", g.Name(), dst.Type())
+	}
+	t := dst.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Tag.Get("flag")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = utils.KebabCase(field.Name)
+		}
+
+		item, ok := g.items[name]
+		if !ok {
+			return fmt.Errorf("cannot decode field %s: dynamic flag %q is not registered in group %q", field.Name, name, g.Name())
+		}
+		v, _ := item.Value.GetAny(id)
+		if v == nil {
+			continue
+		}
+		rv := reflect.ValueOf(v)
+		if !rv.Type().AssignableTo(field.Type) {
+			return fmt.Errorf("cannot decode --%s.%s.%s into field %s: type %s is not assignable to %s",
+				g.Name(), id, name, field.Name, rv.Type(), field.Type)
+		}
+		dst.Field(i).Set(rv)
+	}
+	return nil
+}
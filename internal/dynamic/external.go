@@ -0,0 +1,28 @@
+package dynamic
+
+import (
+	"flag"
+
+	"github.com/containeroo/tinyflags/internal/utils"
+)
+
+// Func registers a dynamic field that calls fn for each value and stores the raw input per ID.
+func (g *Group) Func(field string, usage string, fn func(string) error) *ScalarFlag[string] {
+	return registerDynamicScalar(g, field, "", usage, utils.ParseFunc(fn), utils.FormatString)
+}
+
+// Value registers a dynamic field that sets a fresh flag.Value per ID.
+// The value returned by the first newValue call serves as the default.
+func (g *Group) Value(field string, usage string, newValue func() flag.Value) *ScalarFlag[flag.Value] {
+	return registerDynamicScalar(g, field, newValue(), usage, utils.ParseFlagValue(newValue), utils.FormatFlagValue)
+}
+
+// FuncSlice registers a dynamic slice field that calls fn for each delimited item.
+func (g *Group) FuncSlice(field string, usage string, fn func(string) error) *SliceFlag[string] {
+	return registerDynamicSlice(g, field, nil, usage, utils.ParseFunc(fn), utils.FormatString, false)
+}
+
+// ValueSlice registers a dynamic slice field that sets a fresh flag.Value for each delimited item.
+func (g *Group) ValueSlice(field string, usage string, newValue func() flag.Value) *SliceFlag[flag.Value] {
+	return registerDynamicSlice(g, field, nil, usage, utils.ParseFlagValue(newValue), utils.FormatFlagValue, true)
+}
@@ -1,6 +1,9 @@
 package dynamic
 
-import "github.com/containeroo/tinyflags/internal/core"
+import (
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/utils"
+)
 
 // FlagSetRef is the subset of FlagSet needed by dynamic flags.
 type FlagSetRef interface {
@@ -9,6 +12,8 @@ type FlagSetRef interface {
 	GetOneOfGroup(name string) *core.OneOfGroupGroup
 	OneOfGroups() []*core.OneOfGroupGroup
 	DefaultDelimiter() string
+	ByteUnits() utils.ByteUnits
+	TimeConfig() *utils.TimeConfig
 	LookupFlag(name string) *core.BaseFlag
 	GetAllOrNoneGroup(name string) *core.AllOrNoneGroup
 }
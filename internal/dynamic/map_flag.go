@@ -0,0 +1,106 @@
+package dynamic
+
+import (
+	"fmt"
+
+	"github.com/containeroo/tinyflags/internal/builder"
+)
+
+// MapFlag represents a dynamic key/value map flag with per-ID values.
+type MapFlag[K comparable, V any] struct {
+	*builder.DynamicFlag[map[K]V]                        // Embedded flag metadata
+	item                          *DynamicMapValue[K, V] // Underlying map value store
+}
+
+// Separator sets the string between key and value (default "=").
+func (f *MapFlag[K, V]) Separator(sep string) *MapFlag[K, V] {
+	f.item.input.Separator = sep
+	return f
+}
+
+// Delimiter sets the delimiter between entries in one value; an empty delimiter disables splitting.
+func (f *MapFlag[K, V]) Delimiter(sep string) *MapFlag[K, V] {
+	f.item.input.Delimiter = sep
+	return f
+}
+
+// Quoted splits entries CSV-style: delimiters inside double quotes are kept and a backslash
+// escapes the next character.
+func (f *MapFlag[K, V]) Quoted() *MapFlag[K, V] {
+	f.item.input.Quoted = true
+	return f
+}
+
+// TrimSpace trims whitespace around entries, keys and values (the default).
+func (f *MapFlag[K, V]) TrimSpace() *MapFlag[K, V] {
+	f.item.input.TrimSpace = true
+	return f
+}
+
+// PreserveSpace keeps whitespace around entries, keys and values.
+func (f *MapFlag[K, V]) PreserveSpace() *MapFlag[K, V] {
+	f.item.input.TrimSpace = false
+	return f
+}
+
+// AllowEmpty skips empty entries (e.g., "a=1,,b=2") instead of failing.
+func (f *MapFlag[K, V]) AllowEmpty() *MapFlag[K, V] {
+	f.item.input.AllowEmpty = true
+	return f
+}
+
+// UniqueKeys rejects keys given more than once per ID instead of keeping the last value.
+func (f *MapFlag[K, V]) UniqueKeys() *MapFlag[K, V] {
+	f.item.input.UniqueKeys = true
+	return f
+}
+
+// Validate sets a custom validation function for each entry.
+func (f *MapFlag[K, V]) Validate(fn func(K, V) error) *MapFlag[K, V] {
+	f.item.hooks.Validate = fn
+	return f
+}
+
+// Default returns the default value.
+func (f *MapFlag[K, V]) Default() map[K]V {
+	return f.item.def
+}
+
+// Changed returns true if the value was changed.
+func (f *MapFlag[K, V]) Changed() bool {
+	return f.item.changed
+}
+
+// Has reports whether a value is set for the given ID.
+func (f *MapFlag[K, V]) Has(id string) bool {
+	_, ok := f.item.values[id]
+	return ok
+}
+
+// Get returns the map for a given ID and whether it exists.
+func (f *MapFlag[K, V]) Get(id string) (map[K]V, bool) {
+	val, ok := f.item.values[id]
+	if !ok {
+		return f.item.def, false
+	}
+	return val, true
+}
+
+// MustGet returns the value or panics if it is not set.
+func (f *MapFlag[K, V]) MustGet(id string) map[K]V {
+	val, ok := f.Get(id)
+	if !ok {
+		panic(fmt.Sprintf("missing required value for %s (%s)", f.item.field, id))
+	}
+	return val
+}
+
+// Values returns all parsed values keyed by ID.
+func (f *MapFlag[K, V]) Values() map[string]map[K]V {
+	return f.item.values
+}
+
+// ValuesAny returns all values as a map of any.
+func (f *MapFlag[K, V]) ValuesAny() map[string]any {
+	return f.item.ValuesAny()
+}
@@ -0,0 +1,38 @@
+package dynamic
+
+import (
+	"github.com/containeroo/tinyflags/internal/builder"
+	"github.com/containeroo/tinyflags/internal/core"
+)
+
+// Map registers a dynamic map field with caller-supplied key and value hooks.
+func Map[K comparable, V any](
+	g *Group,
+	field string,
+	def map[K]V,
+	usage string,
+	parseKey func(string) (K, error),
+	formatKey func(K) string,
+	parseValue func(string) (V, error),
+	formatValue func(V) string,
+) *MapFlag[K, V] {
+	// Create a map value with default delimiter from the flagset
+	val := NewDynamicMapValue(field, def, parseKey, formatKey, parseValue, formatValue, g.fs.DefaultDelimiter())
+
+	// Construct CLI-facing flag placeholder that renders the current default
+	bf := &core.BaseFlag{
+		Name:  field,
+		Usage: usage,
+		Value: &mapPlaceholder{def: val.Default},
+	}
+
+	// Register flag and value in the group
+	g.items[field] = core.GroupItem{Value: val, Flag: bf}
+	g.itemOrder = append(g.itemOrder, bf)
+
+	// Return wrapper with typed access
+	return &MapFlag[K, V]{
+		DynamicFlag: builder.NewDynamicFlag[map[K]V](g.fs, bf, val),
+		item:        val,
+	}
+}
@@ -0,0 +1,112 @@
+package dynamic
+
+import (
+	"maps"
+	"reflect"
+
+	"github.com/containeroo/tinyflags/internal/core"
+)
+
+// DynamicMapValue holds parsed key/value maps per ID.
+type DynamicMapValue[K comparable, V any] struct {
+	field   string              // Flag field name
+	def     map[K]V             // Default map value
+	changed bool                // Whether the value was changed
+	input   core.MapInputConfig // Shared entry-splitting behavior
+	hooks   core.MapHooks[K, V] // Key/value parse, format and validate behavior
+	values  map[string]map[K]V  // Parsed values per ID
+}
+
+// NewDynamicMapValue creates a new dynamic map value.
+func NewDynamicMapValue[K comparable, V any](
+	field string,
+	def map[K]V,
+	parseKey func(string) (K, error),
+	formatKey func(K) string,
+	parseValue func(string) (V, error),
+	formatValue func(V) string,
+	delimiter string,
+) *DynamicMapValue[K, V] {
+	return &DynamicMapValue[K, V]{
+		field: field,
+		def:   maps.Clone(def),
+		input: core.MapInputConfig{
+			SliceInputConfig: core.SliceInputConfig{Delimiter: delimiter, TrimSpace: true},
+			Separator:        "=",
+		},
+		hooks:  core.NewMapHooks(parseKey, formatKey, parseValue, formatValue),
+		values: make(map[string]map[K]V),
+	}
+}
+
+// Set parses one or more delimited entries and adds them to the map of the given ID.
+func (d *DynamicMapValue[K, V]) Set(id, raw string) error {
+	m, ok := d.values[id]
+	if !ok {
+		m = make(map[K]V)
+	}
+	if err := d.hooks.Merge(m, raw, &d.input); err != nil {
+		return err
+	}
+	d.values[id] = m
+	d.changed = true
+	return nil
+}
+
+// FieldName returns the field name of the flag.
+func (d *DynamicMapValue[K, V]) FieldName() string {
+	return d.field
+}
+
+// Default returns the default entries sorted by key.
+func (d *DynamicMapValue[K, V]) Default() string {
+	return d.input.Join(d.hooks.Format(d.def, d.input.Separator))
+}
+
+// ApplyDefaultFinalize is a no-op; map defaults are not finalized.
+func (d *DynamicMapValue[K, V]) ApplyDefaultFinalize() {}
+
+// GetAny returns the map as any for a given ID, falling back to default.
+func (d *DynamicMapValue[K, V]) GetAny(id string) (any, bool) {
+	val, ok := d.values[id]
+	if ok {
+		return val, true
+	}
+	return d.def, false
+}
+
+// ValuesAny returns all values as a map of any.
+func (d *DynamicMapValue[K, V]) ValuesAny() map[string]any {
+	out := make(map[string]any, len(d.values))
+	for k, v := range d.values {
+		out[k] = v
+	}
+	return out
+}
+
+// ResetParseState clears all parsed IDs.
+func (d *DynamicMapValue[K, V]) ResetParseState() {
+	clear(d.values)
+	d.changed = false
+}
+
+// FormatItems returns the entries for id, or the defaults, in command-line form.
+func (d *DynamicMapValue[K, V]) FormatItems(id string) []string {
+	m, ok := d.values[id]
+	if !ok {
+		m = d.def
+	}
+	return d.hooks.Format(m, d.input.Separator)
+}
+
+// ItemDelimiter returns the separator used to split and join entries.
+func (d *DynamicMapValue[K, V]) ItemDelimiter() string { return d.input.Delimiter }
+
+// MapSeparator returns the separator between keys and values.
+func (d *DynamicMapValue[K, V]) MapSeparator() string { return d.input.Separator }
+
+// QuoteItem quotes an entry for quoted splitting; other entries are returned unchanged.
+func (d *DynamicMapValue[K, V]) QuoteItem(item string) string { return d.input.QuoteItem(item) }
+
+// TypeName returns the Go type of each instance's parsed map.
+func (d *DynamicMapValue[K, V]) TypeName() string { return reflect.TypeFor[map[K]V]().String() }
@@ -0,0 +1,8 @@
+package dynamic
+
+import "github.com/containeroo/tinyflags/internal/utils"
+
+// StringMap
+func (g *Group) StringMap(field string, def map[string]string, usage string) *MapFlag[string, string] {
+	return Map(g, field, def, usage, utils.ParseString, utils.FormatString, utils.ParseString, utils.FormatString)
+}
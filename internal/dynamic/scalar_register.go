@@ -5,6 +5,11 @@ import (
 	"github.com/containeroo/tinyflags/internal/core"
 )
 
+// Scalar registers a dynamic scalar field with caller-supplied parse and format hooks.
+func Scalar[T any](g *Group, field string, def T, usage string, parse func(string) (T, error), format func(T) string) *ScalarFlag[T] {
+	return registerDynamicScalar(g, field, def, usage, parse, format)
+}
+
 // registerDynamicScalar registers a scalar field under the group.
 func registerDynamicScalar[T any](
 	g *Group,
@@ -21,7 +26,7 @@ func registerDynamicScalar[T any](
 	bf := &core.BaseFlag{
 		Name:  field,
 		Usage: usage,
-		Value: &placeholderValue{def: format(def)},
+		Value: &placeholderValue{def: func() string { return format(def) }},
 	}
 
 	// Register the flag and its value in the group
@@ -30,7 +35,7 @@ func registerDynamicScalar[T any](
 
 	// Return typed wrapper for external use
 	return &ScalarFlag[T]{
-		DynamicFlag: builder.NewDynamicFlag[T](g.fs, bf),
+		DynamicFlag: builder.NewDynamicFlag[T](g.fs, bf, val),
 		item:        val.Base(),
 	}
 }
@@ -3,6 +3,7 @@ package dynamic
 import (
 	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/utils"
+	"reflect"
 )
 
 // DynamicScalarValue holds parsed scalar values per ID with parsing, formatting, and validation.
@@ -106,3 +107,15 @@ func (d *DynamicScalarValue[T]) ResetParseState() {
 	clear(d.values)
 	utils.ResetScalarState(&d.def, d.baseDef, &d.changed, &d.hooks.DefaultFinalized)
 }
+
+// FormatItems returns the value for id, or the default, in command-line form.
+func (d *DynamicScalarValue[T]) FormatItems(id string) []string {
+	val, ok := d.values[id]
+	if !ok {
+		val = d.def
+	}
+	return []string{d.hooks.Format(val)}
+}
+
+// TypeName returns the Go type of each instance's parsed value.
+func (d *DynamicScalarValue[T]) TypeName() string { return reflect.TypeFor[T]().String() }
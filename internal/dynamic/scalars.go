@@ -3,13 +3,17 @@ package dynamic
 import (
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
+	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/utils"
 )
 
@@ -170,6 +174,31 @@ func (g *Group) Int64(field string, def int64, usage string) *ScalarFlag[int64]
 	)
 }
 
+// Uint
+func (g *Group) Uint(field string, def uint, usage string) *ScalarFlag[uint] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseUnsigned[uint], utils.FormatUnsigned[uint])
+}
+
+// Uint8
+func (g *Group) Uint8(field string, def uint8, usage string) *ScalarFlag[uint8] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseUnsigned[uint8], utils.FormatUnsigned[uint8])
+}
+
+// Uint16
+func (g *Group) Uint16(field string, def uint16, usage string) *ScalarFlag[uint16] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseUnsigned[uint16], utils.FormatUnsigned[uint16])
+}
+
+// Uint32
+func (g *Group) Uint32(field string, def uint32, usage string) *ScalarFlag[uint32] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseUnsigned[uint32], utils.FormatUnsigned[uint32])
+}
+
+// Uint64
+func (g *Group) Uint64(field string, def uint64, usage string) *ScalarFlag[uint64] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseUnsigned[uint64], utils.FormatUnsigned[uint64])
+}
+
 // Duration
 func (g *Group) Duration(field string, def time.Duration, usage string) *ScalarFlag[time.Duration] {
 	return registerDynamicScalar(g, field, def, usage, time.ParseDuration, time.Duration.String)
@@ -190,6 +219,47 @@ func (g *Group) TCPAddr(field string, def *net.TCPAddr, usage string) *ScalarFla
 	return registerDynamicScalar(g, field, def, usage, utils.ParseTCPAddr, utils.FormatTCPAddr)
 }
 
+// IP
+func (g *Group) IP(field string, def net.IP, usage string) *ScalarFlag[net.IP] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseIP, utils.FormatIP)
+}
+
+// Addr
+func (g *Group) Addr(field string, def netip.Addr, usage string) *ScalarFlag[netip.Addr] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseAddr, utils.FormatAddr)
+}
+
+// AddrPort
+func (g *Group) AddrPort(field string, def netip.AddrPort, usage string) *ScalarFlag[netip.AddrPort] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseAddrPort, utils.FormatAddrPort)
+}
+
+// Prefix
+func (g *Group) Prefix(field string, def netip.Prefix, usage string) *ScalarFlag[netip.Prefix] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParsePrefix, utils.FormatPrefix)
+}
+
+// IPNet
+func (g *Group) IPNet(field string, def *net.IPNet, usage string) *ScalarFlag[*net.IPNet] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseIPNet, utils.FormatIPNet)
+}
+
+// Regexp
+func (g *Group) Regexp(field string, def *regexp.Regexp, usage string) *ScalarFlag[*regexp.Regexp] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseRegexp, utils.FormatRegexp)
+}
+
+// Glob
+func (g *Group) Glob(field string, def string, usage string) *ScalarFlag[string] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseGlob, utils.FormatString)
+}
+
+// Template
+func (g *Group) Template(field string, def *template.Template, usage string) *ScalarFlag[*template.Template] {
+	parse, format := utils.TemplateFuncs(field)
+	return registerDynamicScalar(g, field, def, usage, parse, format)
+}
+
 // URL
 func (g *Group) URL(field string, def *url.URL, usage string) *ScalarFlag[*url.URL] {
 	return registerDynamicScalar(g, field, def, usage, url.Parse, func(u *url.URL) string { return u.String() })
@@ -202,10 +272,27 @@ func (g *Group) File(field string, def *os.File, usage string) *ScalarFlag[*os.F
 
 // Time
 func (g *Group) Time(field string, def time.Time, usage string) *ScalarFlag[time.Time] {
-	return registerDynamicScalar(g, field, def, usage, utils.ParseTime, utils.FormatTime)
+	tc := g.fs.TimeConfig()
+	return registerDynamicScalar(g, field, def, usage, tc.ParseTime, tc.FormatTime)
+}
+
+// TimeRange
+func (g *Group) TimeRange(field string, def utils.TimeRange, usage string) *ScalarFlag[utils.TimeRange] {
+	tc := g.fs.TimeConfig()
+	return registerDynamicScalar(g, field, def, usage, tc.ParseTimeRange, tc.FormatTimeRange)
+}
+
+// Location
+func (g *Group) Location(field string, def *time.Location, usage string) *ScalarFlag[*time.Location] {
+	return registerDynamicScalar(g, field, def, usage, utils.ParseLocation, utils.FormatLocation)
 }
 
 // Bytes
 func (g *Group) Bytes(field string, def uint64, usage string) *ScalarFlag[uint64] {
-	return registerDynamicScalar(g, field, def, usage, utils.ParseBytes, utils.FormatBytes)
+	fl := registerDynamicScalar(g, field, def, usage, utils.ParseBytes, g.formatBytes)
+	g.items[field].Flag.Format = core.FormatBytes
+	return fl
 }
+
+// formatBytes renders a byte size in the flag set's current unit system.
+func (g *Group) formatBytes(b uint64) string { return utils.FormatBytesIn(b, g.fs.ByteUnits()) }
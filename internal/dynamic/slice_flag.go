@@ -19,6 +19,13 @@ func (f *SliceFlag[T]) Delimiter(sep string) *SliceFlag[T] {
 	return f
 }
 
+// Quoted splits input CSV-style: delimiters inside double quotes are kept and a backslash
+// escapes the next character.
+func (f *SliceFlag[T]) Quoted() *SliceFlag[T] {
+	f.item.input.Quoted = true
+	return f
+}
+
 // TrimSpace trims leading and trailing whitespace from each parsed item.
 func (f *SliceFlag[T]) TrimSpace() *SliceFlag[T] {
 	f.item.setTrimSpace(true)
@@ -6,6 +6,11 @@ import (
 	"github.com/containeroo/tinyflags/internal/utils"
 )
 
+// Slice registers a dynamic slice field with caller-supplied per-item parse and format hooks.
+func Slice[T any](g *Group, field string, def []T, usage string, parse func(string) (T, error), format func(T) string) *SliceFlag[T] {
+	return registerDynamicSlice(g, field, def, usage, parse, format, true)
+}
+
 // registerDynamicSlice registers a slice field under the group.
 func registerDynamicSlice[T any](
 	g *Group,
@@ -23,7 +28,7 @@ func registerDynamicSlice[T any](
 	bf := &core.BaseFlag{
 		Name:  field,
 		Usage: usage,
-		Value: &slicePlaceholder{def: utils.JoinFormatted(def, format)},
+		Value: &slicePlaceholder{def: func() string { return utils.JoinFormatted(def, format) }},
 	}
 
 	// Register flag and value in the group
@@ -32,7 +37,7 @@ func registerDynamicSlice[T any](
 
 	// Return wrapper with typed access
 	return &SliceFlag[T]{
-		DynamicFlag: builder.NewDynamicFlag[T](g.fs, bf),
+		DynamicFlag: builder.NewDynamicFlag[T](g.fs, bf, val),
 		item:        val,
 	}
 }
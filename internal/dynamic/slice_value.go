@@ -2,6 +2,7 @@ package dynamic
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/utils"
@@ -132,3 +133,25 @@ func (d *DynamicSliceValue[T]) ResetParseState() {
 	clear(d.values)
 	utils.ResetSliceState(&d.def, d.baseDef, &d.changed, &d.hooks.DefaultFinalized)
 }
+
+// FormatItems returns the items for id, or the defaults, in command-line form.
+func (d *DynamicSliceValue[T]) FormatItems(id string) []string {
+	vals, ok := d.values[id]
+	if !ok {
+		vals = d.def
+	}
+	out := make([]string, 0, len(vals))
+	for _, val := range vals {
+		out = append(out, d.hooks.Format(val))
+	}
+	return out
+}
+
+// ItemDelimiter returns the separator used to split and join items.
+func (d *DynamicSliceValue[T]) ItemDelimiter() string { return d.input.Delimiter }
+
+// QuoteItem quotes an item for quoted splitting; other items are returned unchanged.
+func (d *DynamicSliceValue[T]) QuoteItem(item string) string { return d.input.QuoteItem(item) }
+
+// TypeName returns the Go type of each instance's parsed slice.
+func (d *DynamicSliceValue[T]) TypeName() string { return reflect.TypeFor[[]T]().String() }
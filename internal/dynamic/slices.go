@@ -2,11 +2,15 @@ package dynamic
 
 import (
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
+	"text/template"
 	"time"
 
+	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/utils"
 )
 
@@ -47,6 +51,31 @@ func (g *Group) Int64Slice(field string, def []int64, usage string) *SliceFlag[i
 	)
 }
 
+// UintSlice
+func (g *Group) UintSlice(field string, def []uint, usage string) *SliceFlag[uint] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseUnsigned[uint], utils.FormatUnsigned[uint], true)
+}
+
+// Uint8Slice
+func (g *Group) Uint8Slice(field string, def []uint8, usage string) *SliceFlag[uint8] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseUnsigned[uint8], utils.FormatUnsigned[uint8], true)
+}
+
+// Uint16Slice
+func (g *Group) Uint16Slice(field string, def []uint16, usage string) *SliceFlag[uint16] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseUnsigned[uint16], utils.FormatUnsigned[uint16], true)
+}
+
+// Uint32Slice
+func (g *Group) Uint32Slice(field string, def []uint32, usage string) *SliceFlag[uint32] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseUnsigned[uint32], utils.FormatUnsigned[uint32], true)
+}
+
+// Uint64Slice
+func (g *Group) Uint64Slice(field string, def []uint64, usage string) *SliceFlag[uint64] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseUnsigned[uint64], utils.FormatUnsigned[uint64], true)
+}
+
 // DurationSlice
 func (g *Group) DurationSlice(field string, def []time.Duration, usage string) *SliceFlag[time.Duration] {
 	return registerDynamicSlice(g, field, def, usage, time.ParseDuration, time.Duration.String, true)
@@ -67,6 +96,47 @@ func (g *Group) TCPAddrSlice(field string, def []*net.TCPAddr, usage string) *Sl
 	return registerDynamicSlice(g, field, def, usage, utils.ParseTCPAddr, utils.FormatTCPAddr, true)
 }
 
+// IPSlice
+func (g *Group) IPSlice(field string, def []net.IP, usage string) *SliceFlag[net.IP] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseIP, utils.FormatIP, true)
+}
+
+// AddrSlice
+func (g *Group) AddrSlice(field string, def []netip.Addr, usage string) *SliceFlag[netip.Addr] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseAddr, utils.FormatAddr, true)
+}
+
+// AddrPortSlice
+func (g *Group) AddrPortSlice(field string, def []netip.AddrPort, usage string) *SliceFlag[netip.AddrPort] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseAddrPort, utils.FormatAddrPort, true)
+}
+
+// PrefixSlice
+func (g *Group) PrefixSlice(field string, def []netip.Prefix, usage string) *SliceFlag[netip.Prefix] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParsePrefix, utils.FormatPrefix, true)
+}
+
+// IPNetSlice
+func (g *Group) IPNetSlice(field string, def []*net.IPNet, usage string) *SliceFlag[*net.IPNet] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseIPNet, utils.FormatIPNet, true)
+}
+
+// RegexpSlice
+func (g *Group) RegexpSlice(field string, def []*regexp.Regexp, usage string) *SliceFlag[*regexp.Regexp] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseRegexp, utils.FormatRegexp, false)
+}
+
+// GlobSlice
+func (g *Group) GlobSlice(field string, def []string, usage string) *SliceFlag[string] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseGlob, utils.FormatString, false)
+}
+
+// TemplateSlice
+func (g *Group) TemplateSlice(field string, def []*template.Template, usage string) *SliceFlag[*template.Template] {
+	parse, format := utils.TemplateFuncs(field)
+	return registerDynamicSlice(g, field, def, usage, parse, format, false)
+}
+
 // URLSlice
 func (g *Group) URLSlice(field string, def []*url.URL, usage string) *SliceFlag[*url.URL] {
 	return registerDynamicSlice(g, field, def, usage, url.Parse, func(u *url.URL) string { return u.String() }, true)
@@ -79,10 +149,24 @@ func (g *Group) FileSlice(field string, def []*os.File, usage string) *SliceFlag
 
 // TimeSlice
 func (g *Group) TimeSlice(field string, def []time.Time, usage string) *SliceFlag[time.Time] {
-	return registerDynamicSlice(g, field, def, usage, utils.ParseTime, utils.FormatTime, true)
+	tc := g.fs.TimeConfig()
+	return registerDynamicSlice(g, field, def, usage, tc.ParseTime, tc.FormatTime, true)
+}
+
+// TimeRangeSlice
+func (g *Group) TimeRangeSlice(field string, def []utils.TimeRange, usage string) *SliceFlag[utils.TimeRange] {
+	tc := g.fs.TimeConfig()
+	return registerDynamicSlice(g, field, def, usage, tc.ParseTimeRange, tc.FormatTimeRange, true)
+}
+
+// LocationSlice
+func (g *Group) LocationSlice(field string, def []*time.Location, usage string) *SliceFlag[*time.Location] {
+	return registerDynamicSlice(g, field, def, usage, utils.ParseLocation, utils.FormatLocation, true)
 }
 
 // BytesSlice
 func (g *Group) BytesSlice(field string, def []uint64, usage string) *SliceFlag[uint64] {
-	return registerDynamicSlice(g, field, def, usage, utils.ParseBytes, utils.FormatBytes, true)
+	fl := registerDynamicSlice(g, field, def, usage, utils.ParseBytes, g.formatBytes, true)
+	g.items[field].Flag.Format = core.FormatBytes
+	return fl
 }
@@ -7,7 +7,7 @@ package dynamic
 
 // placeholderValue is a dummy Value used for non-slice scalar flags.
 type placeholderValue struct {
-	def string // String form of default value (for help)
+	def func() string // Renders the default value (for help)
 }
 
 // Set ignores placeholder input.
@@ -20,11 +20,11 @@ func (p *placeholderValue) Get() any { return nil }
 func (p *placeholderValue) Changed() bool { return false }
 
 // Default returns the placeholder default string.
-func (p *placeholderValue) Default() string { return p.def }
+func (p *placeholderValue) Default() string { return p.def() }
 
 // slicePlaceholder is a dummy Value used for slice flags.
 type slicePlaceholder struct {
-	def string // String form of default value (e.g. comma-separated)
+	def func() string // Renders the default value (e.g. comma-separated)
 }
 
 // Set ignores placeholder input.
@@ -37,7 +37,7 @@ func (v *slicePlaceholder) Get() any { return nil }
 func (v *slicePlaceholder) Changed() bool { return false }
 
 // Default returns the placeholder default string.
-func (v *slicePlaceholder) Default() string { return v.def }
+func (v *slicePlaceholder) Default() string { return v.def() }
 
 // IsSlice marks the placeholder as slice-backed.
 func (v *slicePlaceholder) IsSlice() {} // Marker method
@@ -62,3 +62,23 @@ func (v *boolPlaceholder) Default() string { return v.def }
 
 // IsStrictBool reports whether strict bool parsing is enabled.
 func (v *boolPlaceholder) IsStrictBool() bool { return v.strictMode != nil && *v.strictMode }
+
+// mapPlaceholder is a dummy Value used for map flags.
+type mapPlaceholder struct {
+	def func() string // Renders the sorted default entries
+}
+
+// Set ignores placeholder input.
+func (v *mapPlaceholder) Set(string) error { return nil }
+
+// Get returns no concrete placeholder value.
+func (v *mapPlaceholder) Get() any { return nil }
+
+// Changed reports that placeholders are never user-set.
+func (v *mapPlaceholder) Changed() bool { return false }
+
+// Default returns the placeholder default string.
+func (v *mapPlaceholder) Default() string { return v.def() }
+
+// IsSlice marks map flags as repeatable, like slice flags.
+func (v *mapPlaceholder) IsSlice() {} // Marker method
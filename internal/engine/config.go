@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/containeroo/tinyflags/internal/core"
+)
+
+// ConfigFile sets a fixed config file path; a missing file is ignored.
+func (f *FlagSet) ConfigFile(path string) { f.configPath = path }
+
+// ConfigFileFlag reads the config file path from the named static flag.
+func (f *FlagSet) ConfigFileFlag(name string) { f.configFlag = name }
+
+// SetConfigDecoder replaces the config decoder (default: JSON).
+func (f *FlagSet) SetConfigDecoder(d core.ConfigDecoder) { f.configDecoder = d }
+
+// HasConfigSource reports whether a config file path or path flag is configured.
+func (f *FlagSet) HasConfigSource() bool { return f.configPath != "" || f.configFlag != "" }
+
+// ConfigSection is a decoded config document, or the part of it scoped to one command.
+type ConfigSection struct {
+	File   string         // Path the document was read from.
+	Prefix string         // Dot-joined key path of Values inside the document.
+	Values map[string]any // Nested key/value maps.
+	Lines  map[string]int // Line per dot-joined document key, when known.
+}
+
+// Sub returns the nested section under key, or nil when key is not an object.
+func (c *ConfigSection) Sub(key string) *ConfigSection {
+	if c == nil {
+		return nil
+	}
+	values, ok := c.Values[key].(map[string]any)
+	if !ok {
+		return nil
+	}
+	return &ConfigSection{File: c.File, Prefix: c.key(key), Values: values, Lines: c.Lines}
+}
+
+// key returns the document key for a key relative to the section.
+func (c *ConfigSection) key(rel string) string {
+	if c.Prefix == "" {
+		return rel
+	}
+	return c.Prefix + "." + rel
+}
+
+// SetConfigSection hands a config section to the next Parse call.
+func (f *FlagSet) SetConfigSection(section *ConfigSection) { f.configSection = section }
+
+// LoadedConfig returns the document read from this set's config source during the last parse.
+func (f *FlagSet) LoadedConfig() *ConfigSection { return f.configLoaded }
+
+// configFilePath resolves the config path and whether the user asked for it explicitly.
+func (f *FlagSet) configFilePath() (string, bool, error) {
+	if f.configFlag != "" {
+		fl, ok := f.staticFlagsMap[f.configFlag]
+		if !ok {
+			return "", false, fmt.Errorf("config flag --%s is not defined", f.configFlag)
+		}
+		path, ok := fl.Value.Get().(string)
+		if !ok {
+			path = fmt.Sprint(fl.Value.Get())
+		}
+		if path != "" {
+			return path, fl.Value.Changed(), nil
+		}
+	}
+	return f.configPath, false, nil
+}
+
+// loadConfigFile reads and decodes the configured file; nil means no file applies.
+func (f *FlagSet) loadConfigFile() (*ConfigSection, error) {
+	path, explicit, err := f.configFilePath()
+	if err != nil || path == "" {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("config file: %w", err)
+	}
+	defer file.Close() // nolint:errcheck
+
+	decoder := f.configDecoder
+	if decoder == nil {
+		decoder = core.JSONDecoder{}
+	}
+	var doc map[string]any
+	var lines map[string]int
+	if ld, ok := decoder.(core.ConfigLineDecoder); ok {
+		doc, lines, err = ld.DecodeLines(file)
+	} else {
+		doc, err = decoder.Decode(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	if doc == nil {
+		doc = map[string]any{}
+	}
+	return &ConfigSection{File: path, Values: doc, Lines: lines}, nil
+}
@@ -0,0 +1,148 @@
+package engine
+
+import (
+	"cmp"
+	"strings"
+
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/docs"
+)
+
+// DescriptionText returns the description block.
+func (f *FlagSet) DescriptionText() string { return f.desc }
+
+// NoteText returns the notes block.
+func (f *FlagSet) NoteText() string { return f.notes }
+
+// AuthorsText returns the authors block.
+func (f *FlagSet) AuthorsText() string { return f.authors }
+
+// VersionString returns the version printed by --version.
+func (f *FlagSet) VersionString() string { return f.versionString }
+
+// DocFlags describes the visible static flags, including built-in help and version, in help order.
+func (f *FlagSet) DocFlags() []docs.Flag {
+	var out []docs.Flag
+	for _, fl := range f.VisibleStaticFlags() {
+		entry := docFlag(fl)
+		fl.ResolveUsageEnvKey(f.envPrefix, f.hideEnvs)
+		if fl.ShouldShowUsageEnv(f.hideEnvs) {
+			entry.EnvKey = fl.EnvKey
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+// DocGroups describes the visible dynamic groups and their visible fields.
+func (f *FlagSet) DocGroups() []docs.Group {
+	var out []docs.Group
+	for _, group := range f.dynamicGroups() {
+		if group.IsHidden() {
+			continue
+		}
+		entry := docs.Group{
+			Name:        group.Name(),
+			Title:       group.TitleText(),
+			Description: group.DescriptionText(),
+			Note:        group.NoteText(),
+			Placeholder: group.GetPlaceholder(),
+		}
+		if entry.Placeholder == "" {
+			entry.Placeholder = "<ID>"
+		}
+		for _, fl := range group.DynamicFlags() {
+			if fl.Hidden {
+				continue
+			}
+			field := docFlag(fl)
+			if item, ok := group.Items()[fl.Name]; ok {
+				field.Type = docTypeName(item.Value)
+			}
+			field.EnvKey = dynamicDocEnvKey(fl, f.hideEnvs, f.envPrefix, entry.Name, entry.Placeholder)
+			entry.Flags = append(entry.Flags, field)
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+// DocConstraints describes the visible one-of and all-or-none groups.
+func (f *FlagSet) DocConstraints() []docs.Constraint {
+	var out []docs.Constraint
+	for _, group := range f.oneOfGroup {
+		if group.IsHidden() {
+			continue
+		}
+		members := docFlagNames(group.Flags)
+		for _, sub := range group.RequiredGroups {
+			members = append(members, strings.Join(docFlagNames(sub.Flags), " + "))
+		}
+		out = append(out, docs.Constraint{
+			Kind:     docs.OneOf,
+			Title:    cmp.Or(group.TitleText(), group.Name),
+			Required: group.IsRequired(),
+			Members:  members,
+		})
+	}
+	for _, group := range f.allOrNoneGroup {
+		if group.IsHidden() {
+			continue
+		}
+		out = append(out, docs.Constraint{
+			Kind:     docs.AllOrNone,
+			Title:    cmp.Or(group.TitleText(), group.Name),
+			Required: group.IsRequired(),
+			Members:  docFlagNames(group.Flags),
+		})
+	}
+	return out
+}
+
+// docFlag copies the help-visible metadata of a flag; env keys are resolved by the caller.
+func docFlag(fl *core.BaseFlag) docs.Flag {
+	entry := docs.Flag{
+		Name:        fl.Name,
+		Short:       fl.Short,
+		Placeholder: fl.UsagePlaceholder(),
+		Usage:       fl.Usage,
+		Type:        docTypeName(fl.Value),
+		Deprecated:  fl.Deprecated,
+		Required:    fl.Required && !fl.HideRequired,
+	}
+	if !fl.HideAllowed {
+		entry.Allowed = fl.AllowedValues()
+	}
+	if fl.ShouldShowDefaultInHelp() {
+		entry.Default = fl.Value.Default()
+	}
+	if !fl.HideRequires && len(fl.Requires) > 0 {
+		entry.Requires = append([]string(nil), fl.Requires...)
+	}
+	return entry
+}
+
+// dynamicDocEnvKey mirrors the env key shown for dynamic fields in help output.
+func dynamicDocEnvKey(fl *core.BaseFlag, hideEnvs bool, prefix, group, idPlaceholder string) string {
+	if hideEnvs || fl.DisableEnv || fl.HideEnv || prefix == "" {
+		return ""
+	}
+	return core.DynamicEnvKey(prefix, group, idPlaceholder, fl.Name)
+}
+
+// docFlagNames returns the dashed names of flags.
+func docFlagNames(flags []*core.BaseFlag) []string {
+	names := make([]string, len(flags))
+	for i, fl := range flags {
+		names[i] = "--" + fl.Name
+	}
+	return names
+}
+
+// docTypeName returns the type reported by a value, if any.
+func docTypeName(value any) string {
+	if namer, ok := value.(core.TypeNamer); ok {
+		return namer.TypeName()
+	}
+	return ""
+}
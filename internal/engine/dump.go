@@ -0,0 +1,212 @@
+package engine
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/dump"
+)
+
+// DumpEntries returns the resolved value of every static flag and every dynamic instance field.
+// Values pass through MaskFn; built-in help and version flags are left out.
+func (f *FlagSet) DumpEntries() []dump.Entry {
+	var entries []dump.Entry
+
+	for _, fl := range f.staticFlags() {
+		if fl.Value == nil || f.isBuiltinFlag(fl) {
+			continue
+		}
+		entries = append(entries, f.staticDumpEntry(fl))
+	}
+
+	for _, group := range f.dynamicGroups() {
+		ids := group.Instances()
+		for _, id := range ids {
+			for _, fl := range group.Flags() {
+				item, ok := group.Items()[fl.Name]
+				if !ok || item.Value == nil {
+					continue
+				}
+				entries = append(entries, f.dynamicDumpEntry(group.Name(), id, item))
+			}
+		}
+	}
+
+	return entries
+}
+
+// staticDumpEntry resolves one static flag.
+func (f *FlagSet) staticDumpEntry(fl *core.BaseFlag) dump.Entry {
+	val := fl.Value.Get()
+	items, jsonVal := dumpValue(fl, val, func() ([]string, bool) {
+		formatter, ok := fl.Value.(core.ItemFormatter)
+		if !ok {
+			return nil, false
+		}
+		return formatter.FormatItems(), true
+	})
+
+	items = quoteItems(fl.Value, items)
+	entry := dump.Entry{
+		Name:     fl.Name,
+		EnvValue: joinItems(fl.Value, items, f.defaultDelimiter),
+		JSON:     jsonVal,
+	}
+	if !fl.DisableEnv {
+		entry.EnvKey = fl.EnvKey
+		if entry.EnvKey == "" {
+			entry.EnvKey = f.EnvKeyForFlag(fl.Name)
+		}
+	}
+	if fl.IsChanged() {
+		entry.Args = staticDumpArgs(fl, val, items)
+	}
+	return entry
+}
+
+// dynamicDumpEntry resolves one field of one dynamic instance.
+func (f *FlagSet) dynamicDumpEntry(group, id string, item core.GroupItem) dump.Entry {
+	fl := item.Flag
+	val, set := item.Value.GetAny(id)
+	items, jsonVal := dumpValue(fl, val, func() ([]string, bool) {
+		formatter, ok := item.Value.(core.DynamicItemFormatter)
+		if !ok {
+			return nil, false
+		}
+		return formatter.FormatItems(id), true
+	})
+
+	items = quoteItems(item.Value, items)
+	name := group + "." + id + "." + fl.Name
+	entry := dump.Entry{
+		Name:     name,
+		Group:    group,
+		ID:       id,
+		Field:    fl.Name,
+		EnvValue: joinItems(item.Value, items, f.defaultDelimiter),
+		JSON:     jsonVal,
+	}
+	if !fl.DisableEnv {
+		entry.EnvKey = core.DynamicEnvKey(f.envPrefix, group, strings.ToUpper(id), fl.Name)
+	}
+	if set {
+		if b, ok := item.Value.(core.StrictBool); ok && !b.IsStrictBool() {
+			if len(items) == 1 && items[0] == "true" {
+				entry.Args = []string{"--" + name}
+			}
+		} else {
+			entry.Args = itemArgs(name, items)
+		}
+	}
+	return entry
+}
+
+// dumpValue returns the command-line items and the JSON form of a value, applying MaskFn.
+func dumpValue(fl *core.BaseFlag, val any, format func() ([]string, bool)) ([]string, any) {
+	if fl != nil && fl.MaskFn != nil {
+		masked := fl.MaskFn(val)
+		return anyItems(masked), masked
+	}
+	items, ok := format()
+	if !ok {
+		items = anyItems(val)
+	}
+	if isPlainJSON(val) {
+		return items, val
+	}
+	if _, slice := fl.Value.(core.SliceMarker); !slice && len(items) == 1 {
+		return items, items[0]
+	}
+	return items, items
+}
+
+// staticDumpArgs returns the tokens that reproduce a changed static value.
+func staticDumpArgs(fl *core.BaseFlag, val any, items []string) []string {
+	flag := "--" + fl.Name
+	if b, ok := fl.Value.(core.StrictBool); ok && !b.IsStrictBool() {
+		if len(items) == 1 && items[0] == "true" {
+			return []string{flag}
+		}
+		return nil
+	}
+	if _, ok := fl.Value.(core.Incrementable); ok {
+		n, _ := val.(int)
+		def, _ := strconv.Atoi(fl.Value.Default())
+		var args []string
+		for i := def; i < n; i++ {
+			args = append(args, flag)
+		}
+		return args
+	}
+	return itemArgs(fl.Name, items)
+}
+
+// itemArgs renders one --name=item token per item.
+func itemArgs(name string, items []string) []string {
+	args := make([]string, 0, len(items))
+	for _, item := range items {
+		args = append(args, "--"+name+"="+item)
+	}
+	return args
+}
+
+// quoteItems quotes items of values with quoted splitting so they parse back unchanged.
+func quoteItems(v any, items []string) []string {
+	q, ok := v.(core.ItemQuoter)
+	if !ok {
+		return items
+	}
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = q.QuoteItem(item)
+	}
+	return out
+}
+
+// joinItems joins slice items with the value's delimiter for env output.
+func joinItems(v any, items []string, fallback string) string {
+	delim := fallback
+	if d, ok := v.(core.ItemDelimiter); ok {
+		delim = d.ItemDelimiter()
+	}
+	return strings.Join(items, delim)
+}
+
+// anyItems converts a value to strings, expanding slices item by item.
+func anyItems(v any) []string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() != reflect.Uint8 {
+		out := make([]string, rv.Len())
+		for i := range out {
+			out[i] = fmt.Sprint(rv.Index(i).Interface())
+		}
+		return out
+	}
+	return []string{fmt.Sprint(v)}
+}
+
+// isPlainJSON reports whether v is an unnamed bool or number, or a slice of them,
+// which JSON can carry natively without losing the command-line meaning.
+func isPlainJSON(v any) bool {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return false
+	}
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.PkgPath() != "" {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -114,6 +114,41 @@ func TestParseEnvBehavior(t *testing.T) {
 		assert.False(t, port.Has("api"))
 	})
 
+	t.Run("quoted slices split env values", func(t *testing.T) {
+		fs := NewFlagSet("app", ContinueOnError)
+		fs.EnvPrefix("APP")
+		fs.SetGetEnvFn(func(key string) string {
+			if key == "APP_HEADER" {
+				return `"a,b",c`
+			}
+			return ""
+		})
+		fs.getEnvVars = func() []string {
+			return []string{`APP_SVC_API_TAGS="{\"k\":1,\"v\":2}",x\,y`}
+		}
+
+		var headers []string
+		fs.StringSliceVar(&headers, "header", nil, "desc").Quoted()
+		tags := fs.DynamicGroup("svc").StringSlice("tags", nil, "desc").Quoted()
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, []string{"a,b", "c"}, headers)
+		assert.Equal(t, []string{`{"k":1,"v":2}`, "x,y"}, tags.MustGet("api"))
+	})
+
+	t.Run("quoted slices reject unterminated env quotes", func(t *testing.T) {
+		fs := NewFlagSet("app", ContinueOnError)
+		fs.EnvPrefix("APP")
+		fs.getEnvVars = func() []string {
+			return []string{`APP_SVC_API_TAGS="a,b`}
+		}
+		fs.DynamicGroup("svc").StringSlice("tags", nil, "desc").Quoted()
+
+		err := fs.Parse(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unterminated quote")
+	})
+
 	t.Run("dynamic help shows canonical env key", func(t *testing.T) {
 		fs := NewFlagSet("app", ContinueOnError)
 		fs.EnvPrefix("APP")
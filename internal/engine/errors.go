@@ -1,6 +1,11 @@
 package engine
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containeroo/tinyflags/internal/suggest"
+)
 
 type ErrorHandling int
 
@@ -54,3 +59,31 @@ func RequestHelp(msg string) error {
 func RequestVersion(msg string) error {
 	return &VersionRequested{Version: msg}
 }
+
+// UnknownFlagError is returned for a flag, dynamic group or dynamic field that is not registered.
+type UnknownFlagError struct {
+	Flag        string   // Flag as typed, e.g. "--verbsoe" or "--htpp.alpha.port=80"
+	Group       string   // Unknown dynamic group name, if the group was not found
+	Field       string   // Unknown dynamic field name, if the field was not found
+	Suggestions []string // Close matches, closest first
+}
+
+// Error describes the unknown flag and lists suggestions, if any.
+func (e *UnknownFlagError) Error() string {
+	var msg string
+	switch {
+	case e.Group != "":
+		msg = fmt.Sprintf("unknown dynamic group %q in flag %s", e.Group, e.Flag)
+	case e.Field != "":
+		msg = fmt.Sprintf("unknown dynamic field %q in flag %s", e.Field, e.Flag)
+	default:
+		msg = "unknown flag " + e.Flag
+	}
+	return msg + suggest.Hint(e.Suggestions)
+}
+
+// IsUnknownFlag checks if the error is an UnknownFlagError.
+func IsUnknownFlag(err error) bool {
+	var unknownErr *UnknownFlagError
+	return errors.As(err, &unknownErr)
+}
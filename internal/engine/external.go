@@ -0,0 +1,40 @@
+package engine
+
+import (
+	"encoding"
+	"flag"
+
+	"github.com/containeroo/tinyflags/internal/scalar"
+	"github.com/containeroo/tinyflags/internal/slice"
+	"github.com/containeroo/tinyflags/internal/utils"
+)
+
+// Value defines a flag backed by a stdlib flag.Value.
+func (f *FlagSet) Value(v flag.Value, name string, usage string) *scalar.ValueFlag {
+	return scalar.NewValueFlag(f, v, name, usage)
+}
+
+// TextVar defines a flag backed by an encoding.TextUnmarshaler.
+func (f *FlagSet) TextVar(p encoding.TextUnmarshaler, name string, def encoding.TextMarshaler, usage string) *scalar.ValueFlag {
+	return scalar.NewValueFlag(f, scalar.NewTextValue(p, def), name, usage)
+}
+
+// Func defines a flag that calls fn for each occurrence.
+func (f *FlagSet) Func(name string, usage string, fn func(string) error) *scalar.ValueFlag {
+	return scalar.NewValueFlag(f, scalar.NewFuncValue(fn, false), name, usage)
+}
+
+// BoolFunc defines a flag that calls fn for each occurrence without requiring a value.
+func (f *FlagSet) BoolFunc(name string, usage string, fn func(string) error) *scalar.ValueFlag {
+	return scalar.NewValueFlag(f, scalar.NewFuncValue(fn, true), name, usage)
+}
+
+// FuncSlice defines a slice flag that calls fn for each delimited item.
+func (f *FlagSet) FuncSlice(name string, usage string, fn func(string) error) *slice.SliceFlag[string] {
+	return RegisterStaticSlice(f, new([]string), name, usage, nil, utils.ParseFunc(fn), utils.FormatString, f.DefaultDelimiter(), false)
+}
+
+// ValueSlice defines a slice flag that sets a fresh flag.Value for each delimited item.
+func (f *FlagSet) ValueSlice(newValue func() flag.Value, name string, usage string) *slice.SliceFlag[flag.Value] {
+	return RegisterStaticSlice(f, new([]flag.Value), name, usage, nil, utils.ParseFlagValue(newValue), utils.FormatFlagValue, f.DefaultDelimiter(), true)
+}
@@ -20,3 +20,15 @@ func (f *FlagSet) staticFlags() []*core.BaseFlag {
 	}
 	return f.staticFlagsOrder
 }
+
+// VisibleStaticFlags returns non-hidden static flags in help order, including built-in help and version flags.
+func (f *FlagSet) VisibleStaticFlags() []*core.BaseFlag {
+	f.maybeAddBuiltinFlags()
+	var out []*core.BaseFlag
+	for _, fl := range f.staticFlags() {
+		if !fl.Hidden {
+			out = append(out, fl)
+		}
+	}
+	return out
+}
@@ -2,13 +2,17 @@ package engine
 
 import (
 	"cmp"
+	"fmt"
 	"io"
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/dynamic"
+	"github.com/containeroo/tinyflags/internal/suggest"
+	"github.com/containeroo/tinyflags/internal/utils"
 )
 
 // FlagSet manages the definition, parsing, and usage output of command-line flags.
@@ -25,6 +29,9 @@ type FlagSet struct {
 	requiredPositional int                              // Required positional argument count
 	validatePositional func(string) error               // Function to validate positional arguments
 	finalizePositional func(string) string              // Function to finalize positional arguments
+	completePositional core.CompleteFunc                // Runtime completer for positional arguments
+	positionalArgs     []core.PositionalArg             // Named positional arguments in declaration order
+	afterParse         []func() error                   // Hooks run after a successful parse
 	envPrefix          string                           // Optional ENV prefix (e.g. "APP_")
 	envKeyFunc         EnvKeyFunc                       // Function to derive env keys from prefix+flag name
 	getEnv             func(string) string              // Function used to read ENV vars (default: os.Getenv)
@@ -32,6 +39,10 @@ type FlagSet struct {
 	hideEnvs           bool                             // Globally hide environment key hints
 	ignoreInvalidEnv   bool                             // Whether to ignore unknown ENV overrides
 	defaultDelimiter   string                           // Global slice delimiter (default: ",")
+	byteUnits          utils.ByteUnits                  // Unit system for rendering byte sizes
+	byteUnitsSet       bool                             // Whether byteUnits was set on this flag set
+	inherit            *FlagSet                         // Flag set whose settings apply where this one sets none
+	timeConfig         utils.TimeConfig                 // Layouts, location and clock for time flags
 	title              string                           // Title shown in usage output
 	desc               string                           // Prolog before flags
 	notes              string                           // Epilog after flags
@@ -51,6 +62,8 @@ type FlagSet struct {
 	authors            string                           // Optional authors block
 	beforeParse        func([]string) ([]string, error) // Hook to preprocess args
 	unknownFlag        func(string) error               // Handler for unknown flags
+	suggestDistance    int                              // Maximum edit distance for suggestions (0 disables)
+	suggestScopes      []*FlagSet                       // Extra flag sets searched for flag suggestions
 
 	// Indentation and width config for description
 	descIndent int
@@ -71,6 +84,13 @@ type FlagSet struct {
 	// Indentation and width config for notes
 	noteIndent int
 	noteWidth  int
+
+	// Config file source and the values handed to the next parse
+	configPath    string
+	configFlag    string
+	configDecoder core.ConfigDecoder
+	configSection *ConfigSection
+	configLoaded  *ConfigSection
 }
 
 // NewFlagSet creates a new FlagSet with the given name and error handling policy.
@@ -95,6 +115,7 @@ func NewFlagSet(name string, errorHandling ErrorHandling) *FlagSet {
 		noteIndent:         0,
 		noteWidth:          400,
 		title:              "Flags:",
+		suggestDistance:    suggest.DefaultDistance,
 	}
 
 	fs.Usage = func() {
@@ -112,6 +133,7 @@ func NewFlagSet(name string, errorHandling ErrorHandling) *FlagSet {
 		fs.PrintDescription(out, fs.descIndent, fs.descWidth)
 		fs.PrintStaticDefaults(out, fs.usageStaticIndent, fs.usageStaticCol, fs.usageStaticWidth)
 		fs.PrintDynamicDefaults(out, fs.usageDynamicIndent, fs.usageDynamicCol, fs.usageDynamicWidth)
+		fs.PrintArguments(out, fs.usageStaticIndent, fs.usageStaticWidth)
 		fs.PrintNotes(out, fs.noteIndent, fs.noteWidth)
 	}
 
@@ -138,12 +160,56 @@ func (f *FlagSet) DefaultDelimiter() string { return f.defaultDelimiter }
 // GlobalDelimiter sets the default slice delimiter.
 func (f *FlagSet) GlobalDelimiter(s string) { f.defaultDelimiter = s }
 
+// InheritSettings makes f use the byte units and time settings of parent unless f sets its own.
+func (f *FlagSet) InheritSettings(parent *FlagSet) {
+	f.inherit = parent
+	f.timeConfig.Parent = &parent.timeConfig
+}
+
+// ByteUnits returns the unit system used to render byte sizes.
+func (f *FlagSet) ByteUnits() utils.ByteUnits {
+	if !f.byteUnitsSet && f.inherit != nil {
+		return f.inherit.ByteUnits()
+	}
+	return f.byteUnits
+}
+
+// SetByteUnits sets the unit system used to render byte sizes.
+func (f *FlagSet) SetByteUnits(u utils.ByteUnits) {
+	f.byteUnits = u
+	f.byteUnitsSet = true
+}
+
+// TimeConfig returns the layouts, location and clock used by time flags.
+func (f *FlagSet) TimeConfig() *utils.TimeConfig { return &f.timeConfig }
+
+// SetTimeLayouts sets the layouts time flags accept, in order; the first one formats values.
+func (f *FlagSet) SetTimeLayouts(layouts ...string) { f.timeConfig.Layouts = layouts }
+
+// SetTimeLocation sets the location for time layouts without a zone.
+func (f *FlagSet) SetTimeLocation(loc *time.Location) { f.timeConfig.Location = loc }
+
+// SetNowFn sets the clock that resolves relative times such as now-2h.
+func (f *FlagSet) SetNowFn(fn func() time.Time) { f.timeConfig.Now = fn }
+
 // BeforeParse sets a hook that can rewrite args before parsing.
 func (f *FlagSet) BeforeParse(fn func([]string) ([]string, error)) { f.beforeParse = fn }
 
 // OnUnknownFlag sets the callback for unknown flags.
 func (f *FlagSet) OnUnknownFlag(fn func(string) error) { f.unknownFlag = fn }
 
+// SetSuggestionDistance sets the maximum edit distance for "did you mean" suggestions; 0 disables them.
+func (f *FlagSet) SetSuggestionDistance(n int) { f.suggestDistance = n }
+
+// SuggestionDistance returns the maximum edit distance for suggestions.
+func (f *FlagSet) SuggestionDistance() int { return f.suggestDistance }
+
+// DisableSuggestions turns off "did you mean" suggestions.
+func (f *FlagSet) DisableSuggestions() { f.suggestDistance = 0 }
+
+// SuggestFrom adds flag sets whose flags are also suggested for unknown flags, e.g. inherited globals.
+func (f *FlagSet) SuggestFrom(sets ...*FlagSet) { f.suggestScopes = sets }
+
 // Version enables the version flag and sets its output string.
 func (f *FlagSet) Version(s string) { f.versionString = s; f.enableVer = true }
 
@@ -223,6 +289,53 @@ func (f *FlagSet) SetPositionalValidate(fn func(string) error) { f.validatePosit
 // SetPositionalFinalize sets the positional finalization hook.
 func (f *FlagSet) SetPositionalFinalize(fn func(string) string) { f.finalizePositional = fn }
 
+// CompleteArgs sets a runtime completer for positional arguments.
+func (f *FlagSet) CompleteArgs(fn core.CompleteFunc) { f.completePositional = fn }
+
+// ArgsCompleter returns the runtime completer for positional arguments.
+func (f *FlagSet) ArgsCompleter() core.CompleteFunc { return f.completePositional }
+
+// AddPositional registers a named positional argument after the existing ones.
+// Only required single arguments may follow a variadic one, and no required argument may follow an optional one.
+func (f *FlagSet) AddPositional(arg core.PositionalArg) {
+	minVals, maxVals := arg.Arity()
+	for _, prev := range f.positionalArgs {
+		if prev.Name() == arg.Name() {
+			panic(fmt.Sprintf("tinyflags: positional argument %q already defined", arg.Name()))
+		}
+		prevMin, prevMax := prev.Arity()
+		if prevMax != 1 && (minVals == 0 || maxVals != 1) {
+			panic(fmt.Sprintf("tinyflags: positional %q cannot follow variadic %q", arg.Name(), prev.Name()))
+		}
+		if minVals > 0 && prevMin == 0 && prevMax == 1 {
+			panic(fmt.Sprintf("tinyflags: required positional %q cannot follow optional %q", arg.Name(), prev.Name()))
+		}
+	}
+	f.positionalArgs = append(f.positionalArgs, arg)
+}
+
+// OnParsed registers a hook that runs after every successful parse, e.g. to copy values into bound structs.
+func (f *FlagSet) OnParsed(fn func() error) { f.afterParse = append(f.afterParse, fn) }
+
+// TakesPositionals reports whether positional arguments were declared by name, count or hook.
+func (f *FlagSet) TakesPositionals() bool {
+	return len(f.positionalArgs) > 0 || f.requiredPositional > 0 ||
+		f.validatePositional != nil || f.finalizePositional != nil || f.completePositional != nil
+}
+
+// PositionalArgs returns the named positional arguments in declaration order.
+func (f *FlagSet) PositionalArgs() []core.PositionalArg { return f.positionalArgs }
+
+// PositionalUsage renders the named positional arguments for a usage line, e.g. " <env> [targets...]".
+func (f *FlagSet) PositionalUsage() string {
+	var b strings.Builder
+	for _, arg := range f.positionalArgs {
+		b.WriteString(" ")
+		b.WriteString(core.PositionalToken(arg))
+	}
+	return b.String()
+}
+
 // --- Usage Formatting Configuration ---
 
 // SetDescIndent sets the description indentation.
@@ -474,12 +587,12 @@ func (f *FlagSet) AttachGroupToOneOf(group string, aon string) {
 func (f *FlagSet) maybeAddBuiltinFlags() {
 	if f.enableHelp && f.showHelp == nil {
 		if _, exists := f.staticFlagsMap["help"]; !exists {
-			f.showHelp = f.Bool("help", false, cmp.Or(f.helpText, "Show help")).Short("h").DisableEnv().Value()
+			f.showHelp = f.Bool("help", false, cmp.Or(f.helpText, "Show help")).Short("h").DisableEnv().DisableConfig().Value()
 		}
 	}
 	if f.enableVer && f.showVersion == nil && f.versionString != "" {
 		if _, exists := f.staticFlagsMap["version"]; !exists {
-			f.showVersion = f.Bool("version", false, cmp.Or(f.versionText, "Show version")).DisableEnv().Value()
+			f.showVersion = f.Bool("version", false, cmp.Or(f.versionText, "Show version")).DisableEnv().DisableConfig().Value()
 		}
 	}
 }
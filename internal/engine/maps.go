@@ -0,0 +1,11 @@
+package engine
+
+import (
+	"github.com/containeroo/tinyflags/internal/mapflag"
+	"github.com/containeroo/tinyflags/internal/utils"
+)
+
+// StringMapVar defines a map[string]string flag.
+func (f *FlagSet) StringMapVar(ptr *map[string]string, name string, def map[string]string, usage string) *mapflag.MapFlag[string, string] {
+	return RegisterStaticMap(f, ptr, name, usage, def, utils.ParseString, utils.FormatString, utils.ParseString, utils.FormatString, f.DefaultDelimiter())
+}
@@ -0,0 +1,218 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/containeroo/tinyflags/internal/core"
+)
+
+// parseConfig loads unset flags from the config file or the section handed in by a parent command.
+func (f *FlagSet) parseConfig() error {
+	section := f.configSection
+	f.configSection = nil
+
+	if f.HasConfigSource() {
+		doc, err := f.loadConfigFile()
+		if err != nil {
+			return err
+		}
+		if doc != nil {
+			f.configLoaded = doc
+			section = doc
+		}
+	}
+	if section == nil || len(section.Values) == 0 {
+		return nil
+	}
+
+	flat := make(map[string]any)
+	flattenConfig("", section.Values, flat, f.isMapConfigKey)
+	if err := f.parseStaticConfig(section, flat); err != nil {
+		return err
+	}
+	return f.parseDynamicConfig(section, flat)
+}
+
+// parseStaticConfig loads unset static flags whose name matches a flattened config key.
+func (f *FlagSet) parseStaticConfig(section *ConfigSection, flat map[string]any) error {
+	for _, fl := range f.staticFlags() {
+		if fl.Value == nil || fl.DisableConfig || fl.IsChanged() || fl.Name == f.configFlag {
+			continue
+		}
+		raw, ok := flat[fl.Name]
+		if !ok {
+			continue
+		}
+		items, err := configItems(raw, isSliceFlag(fl), mapSeparator(fl.Value))
+		if err != nil {
+			return fmt.Errorf("invalid value for flag --%s from config: %w", fl.Name, err)
+		}
+		for _, item := range items {
+			if err := fl.Value.Set(item); err != nil {
+				return fmt.Errorf("invalid value for flag --%s from config: %w", fl.Name, err)
+			}
+		}
+		if len(items) > 0 {
+			fl.RecordSource("", configSource(section, fl.Name))
+		}
+	}
+	return nil
+}
+
+// parseDynamicConfig loads dynamic flags from group.id.field config keys.
+func (f *FlagSet) parseDynamicConfig(section *ConfigSection, flat map[string]any) error {
+	if len(f.dynamicGroupsMap) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(flat))
+	for key := range flat {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		parts := strings.Split(key, ".")
+		if len(parts) != 3 {
+			continue
+		}
+		group, ok := f.dynamicGroupsMap[parts[0]]
+		if !ok {
+			continue
+		}
+		id, field := parts[1], parts[2]
+		item, ok := group.Items()[field]
+		if !ok || item.Value == nil || item.Flag == nil || item.Flag.DisableConfig {
+			continue
+		}
+		if _, changed := item.Value.GetAny(id); changed {
+			continue
+		}
+		items, err := configItems(flat[key], isSliceFlag(item.Flag), mapSeparator(item.Value))
+		if err != nil {
+			return fmt.Errorf("invalid value for flag --%s from config: %w", key, err)
+		}
+		for _, raw := range items {
+			if err := item.Value.Set(id, raw); err != nil {
+				return fmt.Errorf("invalid value for flag --%s from config: %w", key, err)
+			}
+		}
+		if len(items) > 0 {
+			item.Flag.RecordSource(id, configSource(section, key))
+		}
+	}
+	return nil
+}
+
+// configSource describes where a flattened section key was defined.
+func configSource(section *ConfigSection, key string) core.Source {
+	docKey := section.key(key)
+	return core.Source{Kind: core.SourceConfig, Key: docKey, File: section.File, Line: section.Lines[docKey]}
+}
+
+// flattenConfig joins nested object keys with dots, keeping lists, scalars and
+// the objects of keys for which keep reports true as leaves.
+func flattenConfig(prefix string, values map[string]any, out map[string]any, keep func(string) bool) {
+	for key, val := range values {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if nested, ok := val.(map[string]any); ok && !keep(key) {
+			flattenConfig(key, nested, out, keep)
+			continue
+		}
+		out[key] = val
+	}
+}
+
+// isMapConfigKey reports whether a flattened config key names a static or dynamic map flag.
+func (f *FlagSet) isMapConfigKey(key string) bool {
+	if fl, ok := f.staticFlagsMap[key]; ok {
+		return mapSeparator(fl.Value) != ""
+	}
+	parts := strings.Split(key, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	group, ok := f.dynamicGroupsMap[parts[0]]
+	if !ok {
+		return false
+	}
+	return mapSeparator(group.Items()[parts[2]].Value) != ""
+}
+
+// mapSeparator returns the key/value separator of map values, or "" for other values.
+func mapSeparator(v any) string {
+	if m, ok := v.(core.MapSeparator); ok {
+		return m.MapSeparator()
+	}
+	return ""
+}
+
+// configItems converts one config leaf into the strings passed to Set.
+// Objects are accepted for map flags, which set one key<sep>value entry per property.
+func configItems(raw any, slice bool, sep string) ([]string, error) {
+	if obj, ok := raw.(map[string]any); ok && sep != "" {
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		items := make([]string, 0, len(obj))
+		for _, key := range keys {
+			s, err := configScalar(obj[key])
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, key+sep+s)
+		}
+		return items, nil
+	}
+	list, isList := raw.([]any)
+	if !isList {
+		if raw == nil {
+			return nil, nil
+		}
+		s, err := configScalar(raw)
+		if err != nil {
+			return nil, err
+		}
+		return []string{s}, nil
+	}
+	if !slice && len(list) != 1 {
+		return nil, fmt.Errorf("expected a single value, got a list of %d", len(list))
+	}
+
+	items := make([]string, 0, len(list))
+	for _, elem := range list {
+		s, err := configScalar(elem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, s)
+	}
+	return items, nil
+}
+
+// configScalar formats a decoded scalar the way it would be typed on the command line.
+func configScalar(raw any) (string, error) {
+	switch v := raw.(type) {
+	case string:
+		return v, nil
+	case []any, map[string]any:
+		return "", fmt.Errorf("unsupported nested value %v", v)
+	case nil:
+		return "", fmt.Errorf("unexpected null")
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// isSliceFlag reports whether the flag accepts multiple values.
+func isSliceFlag(fl *core.BaseFlag) bool {
+	_, ok := fl.Value.(core.SliceMarker)
+	return ok
+}
@@ -33,6 +33,7 @@ func (f *FlagSet) parseStaticEnv() error {
 			}
 			return fmt.Errorf("invalid value for flag --%s from environment: %w", fl.Name, err)
 		}
+		fl.RecordSource("", core.Source{Kind: core.SourceEnv, Key: envKey})
 	}
 	return nil
 }
@@ -82,6 +83,7 @@ func (f *FlagSet) tryParseDynamicEnv(key, val string) error {
 			if err := item.Value.Set(id, val); err != nil {
 				return fmt.Errorf("invalid value for flag --%s.%s.%s from environment %s: %w", group.Name(), id, fl.Name, key, err)
 			}
+			fl.RecordSource(id, core.Source{Kind: core.SourceEnv, Key: key})
 			return nil
 		}
 	}
@@ -0,0 +1,49 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/utils"
+)
+
+// bindPositionalArgs distributes the remaining arguments over the named positional arguments.
+// Each argument takes as many values as it can while leaving enough for the minimums of later ones.
+func (f *FlagSet) bindPositionalArgs() error {
+	if len(f.positionalArgs) == 0 {
+		return nil
+	}
+
+	rest := f.positional
+	for i, arg := range f.positionalArgs {
+		minVals, maxVals := arg.Arity()
+		reserved := 0
+		for _, later := range f.positionalArgs[i+1:] {
+			laterMin, _ := later.Arity()
+			reserved += laterMin
+		}
+
+		take := max(len(rest)-reserved, 0)
+		if maxVals >= 0 {
+			take = min(take, maxVals)
+		}
+		if take < minVals {
+			if maxVals == 1 {
+				return fmt.Errorf("missing required argument %s", core.PositionalToken(arg))
+			}
+			return fmt.Errorf("argument %s requires at least %d value%s, got %d",
+				core.PositionalToken(arg), minVals, utils.PluralSuffix(minVals), take)
+		}
+
+		if err := arg.Set(rest[:take]); err != nil {
+			return fmt.Errorf("invalid value for argument %s: %w", core.PositionalToken(arg), err)
+		}
+		rest = rest[take:]
+	}
+
+	if len(rest) > 0 {
+		limit := len(f.positional) - len(rest)
+		return fmt.Errorf("too many arguments: expected at most %d, got %d", limit, len(f.positional))
+	}
+	return nil
+}
@@ -2,9 +2,14 @@ package engine
 
 import "github.com/containeroo/tinyflags/internal/core"
 
-// resetParseState clears positional args and resets parse lifecycles.
+// resetParseState clears positional args, loaded config and sources and resets parse lifecycles.
 func (f *FlagSet) resetParseState() {
 	f.positional = nil
+	f.configLoaded = nil
+	f.visitFlags(func(fl *core.BaseFlag) { fl.ResetSources() })
+	for _, arg := range f.positionalArgs {
+		arg.ResetParseState()
+	}
 	f.visitParseLifecycles(func(lifecycle core.ParseLifecycle) {
 		lifecycle.ResetParseState()
 	})
@@ -35,3 +40,15 @@ func (f *FlagSet) visitParseLifecycles(visit func(core.ParseLifecycle)) {
 		}
 	}
 }
+
+// visitFlags visits every static flag and every dynamic field flag.
+func (f *FlagSet) visitFlags(visit func(*core.BaseFlag)) {
+	for _, fl := range f.staticFlagsMap {
+		visit(fl)
+	}
+	for _, group := range f.dynamicGroups() {
+		for _, fl := range group.Flags() {
+			visit(fl)
+		}
+	}
+}
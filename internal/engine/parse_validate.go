@@ -15,6 +15,9 @@ func (f *FlagSet) checkPositionals() error {
 	if err := f.finalizePositionals(); err != nil {
 		return f.handleError(err)
 	}
+	if err := f.bindPositionalArgs(); err != nil {
+		return f.handleError(err)
+	}
 	return nil
 }
 
@@ -20,6 +20,7 @@ func (f *FlagSet) Parse(args []string) error {
 	if err := f.parseArgs(args); err != nil {
 		return f.handleError(err)
 	}
+	f.recordCLISources()
 
 	// Check if help was requested
 	if f.enableHelp && f.showHelp != nil && *f.showHelp {
@@ -36,11 +37,15 @@ func (f *FlagSet) Parse(args []string) error {
 		return &VersionRequested{Version: f.versionString}
 	}
 
-	// Load values from env and validate
+	// Load values from env, then config, and validate
 	if err := f.parseEnv(); err != nil {
 		return f.handleError(err)
 	}
+	if err := f.parseConfig(); err != nil {
+		return f.handleError(err)
+	}
 	f.applyDefaultFinalizers()
+	f.recordFinalizerSources()
 	if err := f.checkRequired(); err != nil { // static
 		return f.handleError(err)
 	}
@@ -59,5 +64,10 @@ func (f *FlagSet) Parse(args []string) error {
 	if err := f.checkPositionals(); err != nil {
 		return f.handleError(err)
 	}
+	for _, fn := range f.afterParse {
+		if err := fn(); err != nil {
+			return f.handleError(err)
+		}
+	}
 	return nil
 }
@@ -6,17 +6,22 @@ import (
 
 	"github.com/containeroo/tinyflags/internal/argparse"
 	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/suggest"
 )
 
 // runArgParserFSM initializes the argument parser and runs it.
 // It returns any remaining positional arguments and a parsing error if any.
 func runArgParserFSM(fs *FlagSet, args []string) ([]string, error) {
+	handleUnknown := fs.unknownFlag
+	if handleUnknown == nil {
+		handleUnknown = fs.unknownFlagError
+	}
 	return argparse.Parse(argparse.Config{
 		ContinueOnError:   fs.errorHandling == ContinueOnError,
 		LookupStaticFlag:  fs.lookupStaticFlag,
 		LookupShortFlag:   fs.lookupShortFlag,
 		LookupDynamicFlag: fs.lookupDynamicFlag,
-		HandleUnknownFlag: fs.unknownFlag,
+		HandleUnknownFlag: handleUnknown,
 	}, args)
 }
 
@@ -42,13 +47,53 @@ func (f *FlagSet) lookupDynamicFlag(name string, raw string) (core.DynamicValue,
 
 	group, ok := f.dynamicGroupsMap[groupName]
 	if !ok {
-		return nil, "", fmt.Errorf("unknown dynamic group %q in flag %s", groupName, raw)
+		var candidates []suggest.Candidate
+		for _, g := range f.dynamicGroupsOrder {
+			if !g.IsHidden() {
+				candidates = append(candidates, suggest.Candidate{Name: g.Name(), Display: "--" + g.Name() + "." + id + "." + field})
+			}
+		}
+		return nil, "", &UnknownFlagError{
+			Flag:        raw,
+			Group:       groupName,
+			Suggestions: suggest.Closest(groupName, candidates, f.suggestDistance),
+		}
 	}
 
 	item, ok := group.Items()[field]
 	if !ok {
-		return nil, "", fmt.Errorf("unknown dynamic field %q in flag %s", field, raw)
+		var candidates []suggest.Candidate
+		for _, fl := range group.Flags() {
+			if !fl.Hidden {
+				candidates = append(candidates, suggest.Candidate{Name: fl.Name, Display: "--" + groupName + "." + id + "." + fl.Name})
+			}
+		}
+		return nil, "", &UnknownFlagError{
+			Flag:        raw,
+			Field:       field,
+			Suggestions: suggest.Closest(field, candidates, f.suggestDistance),
+		}
 	}
 
 	return item.Value, id, nil
 }
+
+// unknownFlagError builds the error for an unknown flag, suggesting visible long names and short aliases.
+func (f *FlagSet) unknownFlagError(flag string) error {
+	var candidates []suggest.Candidate
+	for _, fs := range append([]*FlagSet{f}, f.suggestScopes...) {
+		for _, fl := range fs.staticFlags() {
+			if fl.Hidden {
+				continue
+			}
+			candidates = append(candidates, suggest.Candidate{Name: fl.Name, Display: "--" + fl.Name})
+			if fl.Short != "" {
+				candidates = append(candidates, suggest.Candidate{Name: fl.Short, Display: "-" + fl.Short})
+			}
+		}
+	}
+	return &UnknownFlagError{
+		Flag:        flag,
+		Suggestions: suggest.Closest(strings.TrimLeft(flag, "-"), candidates, f.suggestDistance),
+	}
+}
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/mapflag"
 	"github.com/containeroo/tinyflags/internal/scalar"
 	"github.com/containeroo/tinyflags/internal/slice"
 )
@@ -33,3 +34,19 @@ func RegisterStaticSlice[T any](
 	val := slice.NewSliceValue(ptr, def, parse, format, delimiter, trimSpace)
 	return slice.RegisterSlice(reg, name, usage, val, ptr)
 }
+
+// RegisterStaticMap centralizes map flag registration.
+func RegisterStaticMap[K comparable, V any](
+	reg core.Registry,
+	ptr *map[K]V,
+	name, usage string,
+	def map[K]V,
+	parseKey func(string) (K, error),
+	formatKey func(K) string,
+	parseValue func(string) (V, error),
+	formatValue func(V) string,
+	delimiter string,
+) *mapflag.MapFlag[K, V] {
+	val := mapflag.NewMapValue(ptr, def, parseKey, formatKey, parseValue, formatValue, delimiter)
+	return mapflag.RegisterMap(reg, name, usage, val, ptr)
+}
@@ -2,11 +2,15 @@ package engine
 
 import (
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
+	"text/template"
 	"time"
 
+	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/scalar"
 	"github.com/containeroo/tinyflags/internal/utils"
 )
@@ -62,6 +66,31 @@ func (f *FlagSet) Int64Var(ptr *int64, name string, def int64, usage string) *sc
 	)
 }
 
+// UintVar defines a uint flag.
+func (f *FlagSet) UintVar(ptr *uint, name string, def uint, usage string) *scalar.ScalarFlag[uint] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseUnsigned[uint], utils.FormatUnsigned[uint])
+}
+
+// Uint8Var defines a uint8 flag.
+func (f *FlagSet) Uint8Var(ptr *uint8, name string, def uint8, usage string) *scalar.ScalarFlag[uint8] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseUnsigned[uint8], utils.FormatUnsigned[uint8])
+}
+
+// Uint16Var defines a uint16 flag.
+func (f *FlagSet) Uint16Var(ptr *uint16, name string, def uint16, usage string) *scalar.ScalarFlag[uint16] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseUnsigned[uint16], utils.FormatUnsigned[uint16])
+}
+
+// Uint32Var defines a uint32 flag.
+func (f *FlagSet) Uint32Var(ptr *uint32, name string, def uint32, usage string) *scalar.ScalarFlag[uint32] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseUnsigned[uint32], utils.FormatUnsigned[uint32])
+}
+
+// Uint64Var defines a uint64 flag.
+func (f *FlagSet) Uint64Var(ptr *uint64, name string, def uint64, usage string) *scalar.ScalarFlag[uint64] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseUnsigned[uint64], utils.FormatUnsigned[uint64])
+}
+
 // DurationVar defines a time.Duration flag.
 func (f *FlagSet) DurationVar(ptr *time.Duration, name string, def time.Duration, usage string) *scalar.ScalarFlag[time.Duration] {
 	return RegisterStaticScalar(f, ptr, name, usage, def, time.ParseDuration, time.Duration.String)
@@ -92,6 +121,42 @@ func (f *FlagSet) TCPAddrVar(ptr **net.TCPAddr, name string, def *net.TCPAddr, u
 	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseTCPAddr, utils.FormatTCPAddr)
 }
 
+// AddrVar defines a netip.Addr flag.
+func (f *FlagSet) AddrVar(ptr *netip.Addr, name string, def netip.Addr, usage string) *scalar.ScalarFlag[netip.Addr] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseAddr, utils.FormatAddr)
+}
+
+// AddrPortVar defines a netip.AddrPort flag.
+func (f *FlagSet) AddrPortVar(ptr *netip.AddrPort, name string, def netip.AddrPort, usage string) *scalar.ScalarFlag[netip.AddrPort] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseAddrPort, utils.FormatAddrPort)
+}
+
+// PrefixVar defines a netip.Prefix flag.
+func (f *FlagSet) PrefixVar(ptr *netip.Prefix, name string, def netip.Prefix, usage string) *scalar.ScalarFlag[netip.Prefix] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParsePrefix, utils.FormatPrefix)
+}
+
+// IPNetVar defines a *net.IPNet flag.
+func (f *FlagSet) IPNetVar(ptr **net.IPNet, name string, def *net.IPNet, usage string) *scalar.ScalarFlag[*net.IPNet] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseIPNet, utils.FormatIPNet)
+}
+
+// RegexpVar defines a *regexp.Regexp flag compiled while parsing.
+func (f *FlagSet) RegexpVar(ptr **regexp.Regexp, name string, def *regexp.Regexp, usage string) *scalar.ScalarFlag[*regexp.Regexp] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseRegexp, utils.FormatRegexp)
+}
+
+// GlobVar defines a glob pattern flag validated with path.Match.
+func (f *FlagSet) GlobVar(ptr *string, name string, def string, usage string) *scalar.ScalarFlag[string] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseGlob, utils.FormatString)
+}
+
+// TemplateVar defines a text/template flag named after the flag.
+func (f *FlagSet) TemplateVar(ptr **template.Template, name string, def *template.Template, usage string) *scalar.ScalarFlag[*template.Template] {
+	parse, format := utils.TemplateFuncs(name)
+	return RegisterStaticScalar(f, ptr, name, usage, def, parse, format)
+}
+
 // URLVar defines a url.URL flag.
 func (f *FlagSet) URLVar(ptr **url.URL, name string, def *url.URL, usage string) *scalar.ScalarFlag[*url.URL] {
 	return RegisterStaticScalar(f, ptr, name, usage, def, url.Parse, (*url.URL).String)
@@ -102,12 +167,27 @@ func (f *FlagSet) FileVar(ptr **os.File, name string, def *os.File, usage string
 	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseFile, utils.FormatFile)
 }
 
-// TimeVar defines a time.Time flag (parsed as RFC3339).
+// TimeVar defines a time.Time flag parsed with the flag set's time layouts or as a relative time.
 func (f *FlagSet) TimeVar(ptr *time.Time, name string, def time.Time, usage string) *scalar.ScalarFlag[time.Time] {
-	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseTime, utils.FormatTime)
+	return RegisterStaticScalar(f, ptr, name, usage, def, f.timeConfig.ParseTime, f.timeConfig.FormatTime)
+}
+
+// TimeRangeVar defines a utils.TimeRange flag parsed from "start..end".
+func (f *FlagSet) TimeRangeVar(ptr *utils.TimeRange, name string, def utils.TimeRange, usage string) *scalar.ScalarFlag[utils.TimeRange] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, f.timeConfig.ParseTimeRange, f.timeConfig.FormatTimeRange)
+}
+
+// LocationVar defines a *time.Location flag (e.g. "Europe/Zurich").
+func (f *FlagSet) LocationVar(ptr **time.Location, name string, def *time.Location, usage string) *scalar.ScalarFlag[*time.Location] {
+	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseLocation, utils.FormatLocation)
 }
 
 // BytesVar defines a uint64 “bytes” flag (e.g. "1GB", "512M").
 func (f *FlagSet) BytesVar(ptr *uint64, name string, def uint64, usage string) *scalar.ScalarFlag[uint64] {
-	return RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseBytes, utils.FormatBytes)
+	fl := RegisterStaticScalar(f, ptr, name, usage, def, utils.ParseBytes, f.formatBytes)
+	f.LookupFlag(name).Format = core.FormatBytes
+	return fl
 }
+
+// formatBytes renders a byte size in the flag set's current unit system.
+func (f *FlagSet) formatBytes(b uint64) string { return utils.FormatBytesIn(b, f.ByteUnits()) }
@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/schema"
+)
+
+// SchemaCommand describes the flag set, including hidden and built-in flags, as a schema command.
+func (f *FlagSet) SchemaCommand() schema.Command {
+	f.maybeAddBuiltinFlags()
+
+	cmd := schema.Command{
+		Name:        f.name,
+		Path:        f.name,
+		Description: f.desc,
+		Note:        f.notes,
+		Authors:     f.authors,
+		Version:     f.versionString,
+		EnvPrefix:   f.envPrefix,
+	}
+
+	for _, fl := range f.staticFlags() {
+		entry := schemaFlag(fl, fl.Value)
+		if !fl.DisableEnv {
+			entry.EnvKey = fl.EnvKey
+			if entry.EnvKey == "" {
+				entry.EnvKey = f.EnvKeyForFlag(fl.Name)
+			}
+		}
+		if fl.Name == f.configFlag {
+			entry.DisableConfig = true
+		}
+		cmd.Flags = append(cmd.Flags, entry)
+	}
+
+	for _, group := range f.dynamicGroups() {
+		entry := schema.Group{
+			Name:        group.Name(),
+			Title:       group.TitleText(),
+			Description: group.DescriptionText(),
+			Note:        group.NoteText(),
+			Placeholder: group.GetPlaceholder(),
+			Hidden:      group.IsHidden(),
+		}
+		for _, fl := range group.DynamicFlags() {
+			var value any
+			if item, ok := group.Items()[fl.Name]; ok {
+				value = item.Value
+			}
+			field := schemaFlag(fl, value)
+			if !fl.DisableEnv {
+				field.EnvKey = core.DynamicEnvKey(f.envPrefix, entry.Name, "{ID}", fl.Name)
+			}
+			entry.Fields = append(entry.Fields, field)
+		}
+		cmd.Groups = append(cmd.Groups, entry)
+	}
+
+	for _, group := range f.oneOfGroup {
+		entry := schema.OneOfGroup{
+			Name:     group.Name,
+			Title:    group.TitleText(),
+			Required: group.IsRequired(),
+			Hidden:   group.IsHidden(),
+			Flags:    schemaFlagNames(group.Flags),
+		}
+		for _, sub := range group.RequiredGroups {
+			entry.Groups = append(entry.Groups, sub.Name)
+		}
+		cmd.OneOf = append(cmd.OneOf, entry)
+	}
+
+	for _, group := range f.allOrNoneGroup {
+		entry := schema.AllOrNoneGroup{
+			Name:     group.Name,
+			Title:    group.TitleText(),
+			Required: group.IsRequired(),
+			Hidden:   group.IsHidden(),
+			Flags:    schemaFlagNames(group.Flags),
+		}
+		for _, sub := range group.Groups {
+			entry.Groups = append(entry.Groups, sub.Name)
+		}
+		cmd.AllOrNone = append(cmd.AllOrNone, entry)
+	}
+
+	return cmd
+}
+
+// schemaFlag copies the metadata of a flag; value reports the type and may differ from fl.Value for dynamic fields.
+func schemaFlag(fl *core.BaseFlag, value any) schema.Flag {
+	entry := schema.Flag{
+		Name:          fl.Name,
+		Short:         fl.Short,
+		Usage:         fl.Usage,
+		Type:          docTypeName(value),
+		Format:        fl.Format,
+		Placeholder:   fl.UsagePlaceholder(),
+		Section:       fl.Section,
+		Allowed:       append([]string(nil), fl.Allowed...),
+		Requires:      append([]string(nil), fl.Requires...),
+		Deprecated:    fl.Deprecated,
+		Required:      fl.Required,
+		Hidden:        fl.Hidden,
+		DisableEnv:    fl.DisableEnv,
+		DisableConfig: fl.DisableConfig,
+	}
+	if fl.Value != nil {
+		entry.Default = fl.Value.Default()
+		_, entry.Slice = fl.Value.(core.SliceMarker)
+		if bv, ok := fl.Value.(core.StrictBool); ok {
+			entry.Strict = bv.IsStrictBool()
+		}
+	}
+	for _, group := range fl.OneOfGroups {
+		entry.OneOf = append(entry.OneOf, group.Name)
+	}
+	if fl.AllOrNone != nil {
+		entry.AllOrNone = fl.AllOrNone.Name
+	}
+	return entry
+}
+
+// schemaFlagNames returns the names of flags.
+func schemaFlagNames(flags []*core.BaseFlag) []string {
+	names := make([]string, len(flags))
+	for i, fl := range flags {
+		names[i] = fl.Name
+	}
+	return names
+}
@@ -2,11 +2,15 @@ package engine
 
 import (
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
+	"text/template"
 	"time"
 
+	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/slice"
 	"github.com/containeroo/tinyflags/internal/utils"
 )
@@ -46,6 +50,31 @@ func (f *FlagSet) Int64SliceVar(ptr *[]int64, name string, def []int64, usage st
 	)
 }
 
+// UintSliceVar defines a []uint flag.
+func (f *FlagSet) UintSliceVar(ptr *[]uint, name string, def []uint, usage string) *slice.SliceFlag[uint] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseUnsigned[uint], utils.FormatUnsigned[uint], f.DefaultDelimiter(), true)
+}
+
+// Uint8SliceVar defines a []uint8 flag.
+func (f *FlagSet) Uint8SliceVar(ptr *[]uint8, name string, def []uint8, usage string) *slice.SliceFlag[uint8] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseUnsigned[uint8], utils.FormatUnsigned[uint8], f.DefaultDelimiter(), true)
+}
+
+// Uint16SliceVar defines a []uint16 flag.
+func (f *FlagSet) Uint16SliceVar(ptr *[]uint16, name string, def []uint16, usage string) *slice.SliceFlag[uint16] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseUnsigned[uint16], utils.FormatUnsigned[uint16], f.DefaultDelimiter(), true)
+}
+
+// Uint32SliceVar defines a []uint32 flag.
+func (f *FlagSet) Uint32SliceVar(ptr *[]uint32, name string, def []uint32, usage string) *slice.SliceFlag[uint32] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseUnsigned[uint32], utils.FormatUnsigned[uint32], f.DefaultDelimiter(), true)
+}
+
+// Uint64SliceVar defines a []uint64 flag.
+func (f *FlagSet) Uint64SliceVar(ptr *[]uint64, name string, def []uint64, usage string) *slice.SliceFlag[uint64] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseUnsigned[uint64], utils.FormatUnsigned[uint64], f.DefaultDelimiter(), true)
+}
+
 // DurationVar defines a time.Duration flag.
 func (f *FlagSet) DurationSliceVar(ptr *[]time.Duration, name string, def []time.Duration, usage string) *slice.SliceFlag[time.Duration] {
 	return RegisterStaticSlice(f, ptr, name, usage, def, time.ParseDuration, time.Duration.String, f.DefaultDelimiter(), true)
@@ -76,6 +105,42 @@ func (f *FlagSet) TCPAddrSliceVar(ptr *[]*net.TCPAddr, name string, def []*net.T
 	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseTCPAddr, utils.FormatTCPAddr, f.DefaultDelimiter(), true)
 }
 
+// AddrSliceVar defines a []netip.Addr flag.
+func (f *FlagSet) AddrSliceVar(ptr *[]netip.Addr, name string, def []netip.Addr, usage string) *slice.SliceFlag[netip.Addr] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseAddr, utils.FormatAddr, f.DefaultDelimiter(), true)
+}
+
+// AddrPortSliceVar defines a []netip.AddrPort flag.
+func (f *FlagSet) AddrPortSliceVar(ptr *[]netip.AddrPort, name string, def []netip.AddrPort, usage string) *slice.SliceFlag[netip.AddrPort] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseAddrPort, utils.FormatAddrPort, f.DefaultDelimiter(), true)
+}
+
+// PrefixSliceVar defines a []netip.Prefix flag.
+func (f *FlagSet) PrefixSliceVar(ptr *[]netip.Prefix, name string, def []netip.Prefix, usage string) *slice.SliceFlag[netip.Prefix] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParsePrefix, utils.FormatPrefix, f.DefaultDelimiter(), true)
+}
+
+// IPNetSliceVar defines a []*net.IPNet flag.
+func (f *FlagSet) IPNetSliceVar(ptr *[]*net.IPNet, name string, def []*net.IPNet, usage string) *slice.SliceFlag[*net.IPNet] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseIPNet, utils.FormatIPNet, f.DefaultDelimiter(), true)
+}
+
+// RegexpSliceVar defines a []*regexp.Regexp flag.
+func (f *FlagSet) RegexpSliceVar(ptr *[]*regexp.Regexp, name string, def []*regexp.Regexp, usage string) *slice.SliceFlag[*regexp.Regexp] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseRegexp, utils.FormatRegexp, f.DefaultDelimiter(), false)
+}
+
+// GlobSliceVar defines a []string flag.
+func (f *FlagSet) GlobSliceVar(ptr *[]string, name string, def []string, usage string) *slice.SliceFlag[string] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseGlob, utils.FormatString, f.DefaultDelimiter(), false)
+}
+
+// TemplateSliceVar defines a []*template.Template flag.
+func (f *FlagSet) TemplateSliceVar(ptr *[]*template.Template, name string, def []*template.Template, usage string) *slice.SliceFlag[*template.Template] {
+	parse, format := utils.TemplateFuncs(name)
+	return RegisterStaticSlice(f, ptr, name, usage, def, parse, format, f.DefaultDelimiter(), false)
+}
+
 // URLVar defines a url.URL flag.
 func (f *FlagSet) URLSliceVar(ptr *[]*url.URL, name string, def []*url.URL, usage string) *slice.SliceFlag[*url.URL] {
 	return RegisterStaticSlice(f, ptr, name, usage, def, url.Parse, (*url.URL).String, f.DefaultDelimiter(), true)
@@ -86,12 +151,24 @@ func (f *FlagSet) FileSliceVar(ptr *[]*os.File, name string, def []*os.File, usa
 	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseFile, utils.FormatFile, f.DefaultDelimiter(), true)
 }
 
-// TimeVar defines a time.Time flag (parsed as RFC3339).
+// TimeSliceVar defines a []time.Time flag parsed with the flag set's time layouts or as relative times.
 func (f *FlagSet) TimeSliceVar(ptr *[]time.Time, name string, def []time.Time, usage string) *slice.SliceFlag[time.Time] {
-	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseTime, utils.FormatTime, f.DefaultDelimiter(), true)
+	return RegisterStaticSlice(f, ptr, name, usage, def, f.timeConfig.ParseTime, f.timeConfig.FormatTime, f.DefaultDelimiter(), true)
+}
+
+// TimeRangeSliceVar defines a []utils.TimeRange flag.
+func (f *FlagSet) TimeRangeSliceVar(ptr *[]utils.TimeRange, name string, def []utils.TimeRange, usage string) *slice.SliceFlag[utils.TimeRange] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, f.timeConfig.ParseTimeRange, f.timeConfig.FormatTimeRange, f.DefaultDelimiter(), true)
+}
+
+// LocationSliceVar defines a []*time.Location flag.
+func (f *FlagSet) LocationSliceVar(ptr *[]*time.Location, name string, def []*time.Location, usage string) *slice.SliceFlag[*time.Location] {
+	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseLocation, utils.FormatLocation, f.DefaultDelimiter(), true)
 }
 
 // BytesVar defines a uint64 “bytes” flag (e.g. "1GB", "512M").
 func (f *FlagSet) BytesSliceVar(ptr *[]uint64, name string, def []uint64, usage string) *slice.SliceFlag[uint64] {
-	return RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseBytes, utils.FormatBytes, f.DefaultDelimiter(), true)
+	fl := RegisterStaticSlice(f, ptr, name, usage, def, utils.ParseBytes, f.formatBytes, f.DefaultDelimiter(), true)
+	f.LookupFlag(name).Format = core.FormatBytes
+	return fl
 }
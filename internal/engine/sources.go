@@ -0,0 +1,68 @@
+package engine
+
+import "github.com/containeroo/tinyflags/internal/core"
+
+// Sources returns where each flag value came from, keyed like OverriddenValues.
+// Values are included after MaskFn; dynamic entries cover every seen instance.
+func (f *FlagSet) Sources() map[string]core.Source {
+	out := make(map[string]core.Source)
+
+	for _, fl := range f.staticFlagsMap {
+		if fl.Value == nil || f.isBuiltinFlag(fl) {
+			continue
+		}
+		out[fl.Name] = fl.Source()
+	}
+
+	for _, group := range f.dynamicGroups() {
+		ids := group.Instances()
+		for field, item := range group.Items() {
+			if item.Flag == nil || item.Value == nil {
+				continue
+			}
+			for _, id := range ids {
+				val, _ := item.Value.GetAny(id)
+				src, _ := item.Flag.InstanceSource(id, val)
+				out[group.Name()+"."+id+"."+field] = src
+			}
+		}
+	}
+
+	return out
+}
+
+// recordCLISources marks every value set by the argument parser as coming from the command line.
+func (f *FlagSet) recordCLISources() {
+	cli := core.Source{Kind: core.SourceCLI}
+	for _, fl := range f.staticFlagsMap {
+		if fl.IsChanged() {
+			fl.RecordSource("", cli)
+		}
+	}
+	for _, group := range f.dynamicGroups() {
+		for _, item := range group.Items() {
+			di, ok := item.Value.(core.DynamicItemValues)
+			if !ok || item.Flag == nil {
+				continue
+			}
+			for id := range di.ValuesAny() {
+				item.Flag.RecordSource(id, cli)
+			}
+		}
+	}
+}
+
+// recordFinalizerSources marks untouched defaults that a default-value finalizer rewrote.
+func (f *FlagSet) recordFinalizerSources() {
+	for _, fl := range f.staticFlagsMap {
+		reporter, ok := fl.Value.(core.DefaultFinalizeReporter)
+		if ok && !fl.IsChanged() && reporter.DefaultFinalizeApplied() {
+			fl.RecordSource("", core.Source{Kind: core.SourceFinalizer})
+		}
+	}
+}
+
+// isBuiltinFlag reports whether fl is the auto-registered help or version flag.
+func (f *FlagSet) isBuiltinFlag(fl *core.BaseFlag) bool {
+	return (fl.Name == "help" && f.showHelp != nil) || (fl.Name == "version" && f.showVersion != nil)
+}
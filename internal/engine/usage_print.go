@@ -13,10 +13,10 @@ func (f *FlagSet) PrintUsage(w io.Writer, mode FlagPrintMode) {
 
 	switch mode {
 	case PrintNone:
-		fmt.Fprintln(w) // nolint:errcheck
+		fmt.Fprintln(w, f.PositionalUsage()) // nolint:errcheck
 		return
 	case PrintFlags:
-		fmt.Fprintln(w, " [flags]") // nolint:errcheck
+		fmt.Fprintln(w, " [flags]"+f.PositionalUsage()) // nolint:errcheck
 		return
 	}
 
@@ -34,7 +34,7 @@ func (f *FlagSet) PrintUsage(w io.Writer, mode FlagPrintMode) {
 	if helpFlag != nil {
 		printUsageToken(w, helpFlag, mode)
 	}
-	fmt.Fprintln(w) // nolint:errcheck
+	fmt.Fprintln(w, f.PositionalUsage()) // nolint:errcheck
 }
 
 // PrintTitle writes usage title heading.
@@ -58,6 +58,11 @@ func (f *FlagSet) PrintDescription(w io.Writer, indent, maxWidth int) {
 	}
 }
 
+// PrintArguments renders the named positional arguments.
+func (f *FlagSet) PrintArguments(w io.Writer, indent, maxWidth int) {
+	help.PrintArguments(w, f.positionalArgs, indent, maxWidth)
+}
+
 // PrintNotes renders notes block below flags.
 func (f *FlagSet) PrintNotes(w io.Writer, indent, maxWidth int) {
 	if f.notes != "" {
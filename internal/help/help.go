@@ -216,6 +216,34 @@ func PrintDynamicDefaults(w io.Writer, groups []*dynamic.Group, indent, startCol
 	}
 }
 
+// PrintArguments renders named positional arguments under an "Arguments:" heading.
+func PrintArguments(w io.Writer, args []core.PositionalArg, indent, maxWidth int) {
+	if len(args) == 0 {
+		return
+	}
+	startCol := 0
+	for _, arg := range args {
+		startCol = max(startCol, len(core.PositionalToken(arg))+1)
+	}
+	layout := newLayout(indent, startCol, maxWidth)
+	fmt.Fprintln(w, "\nArguments:") // nolint:errcheck
+	for _, arg := range args {
+		layout.writeWrappedRow(w, core.PositionalToken(arg), BuildArgumentDescription(arg))
+	}
+}
+
+// BuildArgumentDescription builds the help text for a positional argument.
+func BuildArgumentDescription(arg core.PositionalArg) string {
+	desc := arg.Usage()
+	if allowed := arg.AllowedValues(); len(allowed) > 0 {
+		desc += " (allowed: " + strings.Join(allowed, ", ") + ")"
+	}
+	if def := arg.Default(); def != "" {
+		desc += " (default: " + def + ")"
+	}
+	return desc
+}
+
 type layout struct {
 	indent   int
 	startCol int
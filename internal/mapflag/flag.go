@@ -0,0 +1,70 @@
+package mapflag
+
+import (
+	"github.com/containeroo/tinyflags/internal/builder"
+)
+
+// MapFlag is the user‐facing builder for map flags.
+type MapFlag[K comparable, V any] struct {
+	builder.StaticFlag[map[K]V, *MapFlag[K, V]]
+	val *MapValue[K, V]
+}
+
+// Separator sets the string between key and value (default "=").
+func (f *MapFlag[K, V]) Separator(sep string) *MapFlag[K, V] {
+	f.val.input.Separator = sep
+	return f
+}
+
+// Delimiter sets the delimiter between entries in one value; an empty delimiter disables splitting.
+func (f *MapFlag[K, V]) Delimiter(sep string) *MapFlag[K, V] {
+	f.val.input.Delimiter = sep
+	return f
+}
+
+// Quoted splits entries CSV-style: delimiters inside double quotes are kept and a backslash
+// escapes the next character.
+func (f *MapFlag[K, V]) Quoted() *MapFlag[K, V] {
+	f.val.input.Quoted = true
+	return f
+}
+
+// TrimSpace trims whitespace around entries, keys and values (the default).
+func (f *MapFlag[K, V]) TrimSpace() *MapFlag[K, V] {
+	f.val.input.TrimSpace = true
+	return f
+}
+
+// PreserveSpace keeps whitespace around entries, keys and values.
+func (f *MapFlag[K, V]) PreserveSpace() *MapFlag[K, V] {
+	f.val.input.TrimSpace = false
+	return f
+}
+
+// AllowEmpty skips empty entries (e.g., "a=1,,b=2") instead of failing.
+func (f *MapFlag[K, V]) AllowEmpty() *MapFlag[K, V] {
+	f.val.input.AllowEmpty = true
+	return f
+}
+
+// UniqueKeys rejects keys given more than once instead of keeping the last value.
+func (f *MapFlag[K, V]) UniqueKeys() *MapFlag[K, V] {
+	f.val.input.UniqueKeys = true
+	return f
+}
+
+// Validate lets you plug in arbitrary per‐entry checks.
+func (f *MapFlag[K, V]) Validate(fn func(K, V) error) *MapFlag[K, V] {
+	f.val.hooks.Validate = fn
+	return f
+}
+
+// Default returns the default value.
+func (f *MapFlag[K, V]) Default() map[K]V {
+	return f.val.def
+}
+
+// Changed returns true if the value was changed.
+func (f *MapFlag[K, V]) Changed() bool {
+	return f.val.changed
+}
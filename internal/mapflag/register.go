@@ -0,0 +1,33 @@
+package mapflag
+
+import (
+	"github.com/containeroo/tinyflags/internal/builder"
+	"github.com/containeroo/tinyflags/internal/core"
+)
+
+// ValueProvider is the interface for map flags.
+type ValueProvider[K comparable, V any] interface {
+	core.Value
+	Base() *MapValue[K, V]
+}
+
+// RegisterMap registers a map flag.
+func RegisterMap[K comparable, V any](
+	reg core.Registry,
+	name, usage string,
+	val ValueProvider[K, V],
+	ptr *map[K]V,
+) *MapFlag[K, V] {
+	bf := &core.BaseFlag{
+		Name:  name,
+		Usage: usage,
+		Value: val,
+	}
+
+	reg.RegisterFlag(name, bf)
+
+	flag := &MapFlag[K, V]{}
+	flag.StaticFlag = builder.NewStaticFlag(reg, bf, ptr, flag)
+	flag.val = val.Base()
+	return flag
+}
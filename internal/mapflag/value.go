@@ -0,0 +1,111 @@
+package mapflag
+
+import (
+	"maps"
+	"reflect"
+
+	"github.com/containeroo/tinyflags/internal/core"
+)
+
+// MapValue implements key=value map flag parsing and validation.
+type MapValue[K comparable, V any] struct {
+	ptr     *map[K]V
+	def     map[K]V
+	changed bool
+	input   core.MapInputConfig
+	hooks   core.MapHooks[K, V]
+}
+
+// NewMapValue creates a new map value.
+func NewMapValue[K comparable, V any](
+	ptr *map[K]V,
+	def map[K]V,
+	parseKey func(string) (K, error),
+	formatKey func(K) string,
+	parseValue func(string) (V, error),
+	formatValue func(V) string,
+	delimiter string,
+) *MapValue[K, V] {
+	*ptr = cloneMap(def)
+	return &MapValue[K, V]{
+		ptr: ptr,
+		def: def,
+		input: core.MapInputConfig{
+			SliceInputConfig: core.SliceInputConfig{Delimiter: delimiter, TrimSpace: true},
+			Separator:        "=",
+		},
+		hooks: core.NewMapHooks(parseKey, formatKey, parseValue, formatValue),
+	}
+}
+
+// Set parses one or more delimited key/value entries and adds them to the map.
+// The first call replaces the default entries.
+func (v *MapValue[K, V]) Set(s string) error {
+	if !v.changed {
+		*v.ptr = make(map[K]V)
+	}
+	if err := v.hooks.Merge(*v.ptr, s, &v.input); err != nil {
+		return err
+	}
+	v.changed = true
+	return nil
+}
+
+// Get returns the parsed map.
+func (v *MapValue[K, V]) Get() any {
+	return *v.ptr
+}
+
+// Default returns the default entries sorted by key.
+func (v *MapValue[K, V]) Default() string {
+	return v.input.Join(v.formatEntries(v.def))
+}
+
+// Changed returns true if the value was changed.
+func (v *MapValue[K, V]) Changed() bool {
+	return v.changed
+}
+
+// Base returns the underlying value.
+func (v *MapValue[K, V]) Base() *MapValue[K, V] { return v }
+
+// ApplyDefaultFinalize is a no-op; map defaults are not finalized.
+func (v *MapValue[K, V]) ApplyDefaultFinalize() {}
+
+// IsSlice marks map flags as repeatable, like slice flags.
+func (v *MapValue[K, V]) IsSlice() {}
+
+// ResetParseState restores the default entries and clears the changed state.
+func (v *MapValue[K, V]) ResetParseState() {
+	*v.ptr = cloneMap(v.def)
+	v.changed = false
+}
+
+// FormatItems returns the current entries in command-line form, sorted by key.
+func (v *MapValue[K, V]) FormatItems() []string {
+	return v.formatEntries(*v.ptr)
+}
+
+// ItemDelimiter returns the separator used to split and join entries.
+func (v *MapValue[K, V]) ItemDelimiter() string { return v.input.Delimiter }
+
+// MapSeparator returns the separator between keys and values.
+func (v *MapValue[K, V]) MapSeparator() string { return v.input.Separator }
+
+// QuoteItem quotes an entry for quoted splitting; other entries are returned unchanged.
+func (v *MapValue[K, V]) QuoteItem(item string) string { return v.input.QuoteItem(item) }
+
+// TypeName returns the Go type of the parsed map.
+func (v *MapValue[K, V]) TypeName() string { return reflect.TypeFor[map[K]V]().String() }
+
+// formatEntries renders m as sorted key/value items.
+func (v *MapValue[K, V]) formatEntries(m map[K]V) []string {
+	return v.hooks.Format(m, v.input.Separator)
+}
+
+// cloneMap copies m, returning an empty map for nil.
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	out := make(map[K]V, len(m))
+	maps.Copy(out, m)
+	return out
+}
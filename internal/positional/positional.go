@@ -0,0 +1,203 @@
+// Package positional implements typed, named positional arguments.
+package positional
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/containeroo/tinyflags/internal/core"
+	"github.com/containeroo/tinyflags/internal/utils"
+)
+
+// Arg is a single required or optional positional argument.
+type Arg[T any] struct {
+	name     string
+	usage    string
+	ptr      *T
+	def      T
+	optional bool
+	changed  bool
+	allowed  []string
+	hooks    core.ValueHooks[T]
+}
+
+// NewArg creates a single positional argument; optional arguments fall back to def.
+func NewArg[T any](name, usage string, def T, optional bool, parse func(string) (T, error), format func(T) string) *Arg[T] {
+	ptr := new(T)
+	*ptr = def
+	return &Arg[T]{
+		name:     name,
+		usage:    usage,
+		ptr:      ptr,
+		def:      def,
+		optional: optional,
+		hooks:    core.NewValueHooks(parse, format),
+	}
+}
+
+// Name returns the argument name.
+func (a *Arg[T]) Name() string { return a.name }
+
+// Usage returns the help description.
+func (a *Arg[T]) Usage() string { return a.usage }
+
+// Arity reports that the argument takes exactly one value, or at most one when optional.
+func (a *Arg[T]) Arity() (int, int) {
+	if a.optional {
+		return 0, 1
+	}
+	return 1, 1
+}
+
+// Set parses the assigned value; no values keeps the default.
+func (a *Arg[T]) Set(values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	val, err := a.hooks.ParseValue(values[0])
+	if err != nil {
+		return err
+	}
+	*a.ptr = val
+	a.changed = true
+	return nil
+}
+
+// Changed reports whether a value was given.
+func (a *Arg[T]) Changed() bool { return a.changed }
+
+// Default returns the formatted default of an optional argument.
+func (a *Arg[T]) Default() string {
+	if !a.optional {
+		return ""
+	}
+	return a.hooks.DefaultString(a.def)
+}
+
+// AllowedValues returns the values set with Choices.
+func (a *Arg[T]) AllowedValues() []string { return a.allowed }
+
+// ResetParseState restores the default value.
+func (a *Arg[T]) ResetParseState() {
+	*a.ptr = a.def
+	a.changed = false
+}
+
+// TypeName returns the Go type of the argument.
+func (a *Arg[T]) TypeName() string { return reflect.TypeFor[T]().String() }
+
+// Value returns a pointer to the parsed value, suitable as a Command.Run binding.
+func (a *Arg[T]) Value() *T { return a.ptr }
+
+// Choices restricts the argument to the given values.
+func (a *Arg[T]) Choices(allowed ...T) *Arg[T] {
+	a.hooks.SetValidate(utils.AllowOnly(a.hooks.Format, allowed))
+	a.allowed = utils.FormatList(a.hooks.Format, allowed)
+	return a
+}
+
+// Validate sets a custom check for the parsed value.
+func (a *Arg[T]) Validate(fn func(T) error) *Arg[T] {
+	a.hooks.SetValidate(fn)
+	return a
+}
+
+// Finalize sets a function that transforms the parsed value.
+func (a *Arg[T]) Finalize(fn func(T) T) *Arg[T] {
+	a.hooks.SetFinalize(fn)
+	return a
+}
+
+// Variadic is a positional argument that collects a range of values.
+type Variadic[T any] struct {
+	name    string
+	usage   string
+	ptr     *[]T
+	min     int
+	max     int
+	changed bool
+	allowed []string
+	hooks   core.ValueHooks[T]
+}
+
+// NewVariadic creates a variadic argument taking between minVals and maxVals values; maxVals < 0 means unlimited.
+func NewVariadic[T any](name, usage string, minVals, maxVals int, parse func(string) (T, error), format func(T) string) *Variadic[T] {
+	if maxVals < 0 {
+		maxVals = -1
+	}
+	if minVals < 0 || (maxVals >= 0 && maxVals < max(minVals, 1)) {
+		panic(fmt.Sprintf("tinyflags: invalid arity %d..%d for positional %q", minVals, maxVals, name))
+	}
+	return &Variadic[T]{
+		name:  name,
+		usage: usage,
+		ptr:   new([]T),
+		min:   minVals,
+		max:   maxVals,
+		hooks: core.NewValueHooks(parse, format),
+	}
+}
+
+// Name returns the argument name.
+func (v *Variadic[T]) Name() string { return v.name }
+
+// Usage returns the help description.
+func (v *Variadic[T]) Usage() string { return v.usage }
+
+// Arity returns the minimum and maximum number of values; max is -1 when unlimited.
+func (v *Variadic[T]) Arity() (int, int) { return v.min, v.max }
+
+// Set parses each assigned value.
+func (v *Variadic[T]) Set(values []string) error {
+	out := make([]T, 0, len(values))
+	for _, raw := range values {
+		val, err := v.hooks.ParseValue(raw)
+		if err != nil {
+			return err
+		}
+		out = append(out, val)
+	}
+	*v.ptr = out
+	v.changed = len(values) > 0
+	return nil
+}
+
+// Changed reports whether any value was given.
+func (v *Variadic[T]) Changed() bool { return v.changed }
+
+// Default returns "" because variadic arguments default to no values.
+func (v *Variadic[T]) Default() string { return "" }
+
+// AllowedValues returns the values set with Choices.
+func (v *Variadic[T]) AllowedValues() []string { return v.allowed }
+
+// ResetParseState clears the collected values.
+func (v *Variadic[T]) ResetParseState() {
+	*v.ptr = nil
+	v.changed = false
+}
+
+// TypeName returns the Go slice type of the argument.
+func (v *Variadic[T]) TypeName() string { return reflect.TypeFor[[]T]().String() }
+
+// Value returns a pointer to the parsed values, suitable as a Command.Run binding.
+func (v *Variadic[T]) Value() *[]T { return v.ptr }
+
+// Choices restricts every value to the given set.
+func (v *Variadic[T]) Choices(allowed ...T) *Variadic[T] {
+	v.hooks.SetValidate(utils.AllowOnly(v.hooks.Format, allowed))
+	v.allowed = utils.FormatList(v.hooks.Format, allowed)
+	return v
+}
+
+// Validate sets a custom check for each parsed value.
+func (v *Variadic[T]) Validate(fn func(T) error) *Variadic[T] {
+	v.hooks.SetValidate(fn)
+	return v
+}
+
+// Finalize sets a function that transforms each parsed value.
+func (v *Variadic[T]) Finalize(fn func(T) T) *Variadic[T] {
+	v.hooks.SetFinalize(fn)
+	return v
+}
@@ -58,6 +58,9 @@ func (c *CounterValue) Set(s string) error {
 	return c.ScalarValue.Set(s)
 }
 
+// TypeName reports counters separately from plain ints.
+func (c *CounterValue) TypeName() string { return "counter" }
+
 // CounterFlag provides fluent builder methods for counter flags.
 type CounterFlag struct {
 	scalarFlagBase[int, *CounterFlag]
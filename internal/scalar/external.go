@@ -0,0 +1,202 @@
+package scalar
+
+import (
+	"encoding"
+	"flag"
+
+	"github.com/containeroo/tinyflags/internal/builder"
+	"github.com/containeroo/tinyflags/internal/core"
+)
+
+// defaultResetter lets adapters rewind themselves to the registered default between parses.
+type defaultResetter interface {
+	resetDefault(def string)
+}
+
+// ExternalValue adapts a stdlib flag.Value to core.Value.
+type ExternalValue struct {
+	target   flag.Value
+	def      string
+	changed  bool
+	validate func(string) error
+}
+
+// NewExternalValue wraps v and captures its current string form as the default.
+func NewExternalValue(v flag.Value) *ExternalValue {
+	return &ExternalValue{target: v, def: v.String()}
+}
+
+// Set validates the raw input and forwards it to the wrapped value.
+func (v *ExternalValue) Set(s string) error {
+	if v.validate != nil {
+		if err := v.validate(s); err != nil {
+			return err
+		}
+	}
+	if err := v.target.Set(s); err != nil {
+		return err
+	}
+	v.changed = true
+	return nil
+}
+
+// Get returns flag.Getter values when available, otherwise the string form.
+func (v *ExternalValue) Get() any {
+	if getter, ok := v.target.(flag.Getter); ok {
+		return getter.Get()
+	}
+	return v.target.String()
+}
+
+// Changed returns true if the value was changed.
+func (v *ExternalValue) Changed() bool { return v.changed }
+
+// Default returns the string form captured at registration.
+func (v *ExternalValue) Default() string { return v.def }
+
+// FormatItems returns the wrapped value's string form.
+func (v *ExternalValue) FormatItems() []string { return []string{v.target.String()} }
+
+// TypeName reports a generic name; the wrapped flag.Value defines the accepted syntax.
+func (v *ExternalValue) TypeName() string { return "value" }
+
+// ApplyDefaultFinalize is a no-op; external values own their defaults.
+func (v *ExternalValue) ApplyDefaultFinalize() {}
+
+// ResetParseState clears changed state and rewinds the wrapped value when it knows how.
+// Built-in adapters reset themselves and other values opt in with a Reset method;
+// the rest keep what earlier parses set.
+func (v *ExternalValue) ResetParseState() {
+	if v.changed {
+		switch r := v.target.(type) {
+		case defaultResetter:
+			r.resetDefault(v.def)
+		case interface{ Reset() }:
+			r.Reset()
+		}
+	}
+	v.changed = false
+}
+
+// externalBoolValue marks wrapped values whose IsBoolFlag reports true.
+type externalBoolValue struct {
+	*ExternalValue
+}
+
+// IsStrictBool reports false so the flag can be set without a value.
+func (b *externalBoolValue) IsStrictBool() bool { return false }
+
+// ValueFlag provides fluent builder methods for wrapped flag.Value flags.
+type ValueFlag struct {
+	builder.StaticFlag[flag.Value, *ValueFlag]
+	val *ExternalValue
+}
+
+// Validate checks the raw input before it reaches the wrapped value.
+func (f *ValueFlag) Validate(fn func(string) error) *ValueFlag {
+	f.val.validate = fn
+	return f
+}
+
+// Default returns the default string form.
+func (f *ValueFlag) Default() string {
+	return f.val.def
+}
+
+// Changed returns true if the value was changed.
+func (f *ValueFlag) Changed() bool {
+	return f.val.changed
+}
+
+// NewValueFlag registers v as a static flag.
+// Values reporting IsBoolFlag() == true may be set without an argument.
+func NewValueFlag(r core.Registry, v flag.Value, name string, usage string) *ValueFlag {
+	val := NewExternalValue(v)
+
+	var cv core.Value = val
+	if b, ok := v.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+		cv = &externalBoolValue{ExternalValue: val}
+	}
+
+	fl := &ValueFlag{val: val}
+	bf := &core.BaseFlag{
+		Name:  name,
+		Usage: usage,
+		Value: cv,
+	}
+	r.RegisterFlag(name, bf)
+	fl.StaticFlag = builder.NewStaticFlag(r, bf, &val.target, fl)
+	return fl
+}
+
+// NewTextValue adapts p to flag.Value and initializes it from def, like flag.TextVar.
+// It panics if def cannot be round-tripped through p.
+func NewTextValue(p encoding.TextUnmarshaler, def encoding.TextMarshaler) flag.Value {
+	if def != nil {
+		b, err := def.MarshalText()
+		if err != nil {
+			panic(err)
+		}
+		if err := p.UnmarshalText(b); err != nil {
+			panic(err)
+		}
+	}
+	return &textValue{p: p}
+}
+
+// textValue adapts an encoding.TextUnmarshaler to flag.Value.
+type textValue struct {
+	p encoding.TextUnmarshaler
+}
+
+// Set decodes s into the target.
+func (t *textValue) Set(s string) error { return t.p.UnmarshalText([]byte(s)) }
+
+// String encodes the target when it implements encoding.TextMarshaler.
+func (t *textValue) String() string {
+	m, ok := t.p.(encoding.TextMarshaler)
+	if !ok {
+		return ""
+	}
+	b, err := m.MarshalText()
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// Get returns the target itself.
+func (t *textValue) Get() any { return t.p }
+
+// resetDefault decodes the registered default back into the target.
+func (t *textValue) resetDefault(def string) { _ = t.p.UnmarshalText([]byte(def)) }
+
+// NewFuncValue adapts fn to flag.Value. Bool func values may be set without an argument.
+func NewFuncValue(fn func(string) error, isBool bool) flag.Value {
+	return &funcValue{fn: fn, isBool: isBool}
+}
+
+// funcValue adapts a callback to flag.Value and remembers the last accepted input.
+type funcValue struct {
+	fn     func(string) error
+	last   string
+	isBool bool
+}
+
+// Set invokes the callback.
+func (f *funcValue) Set(s string) error {
+	if err := f.fn(s); err != nil {
+		return err
+	}
+	f.last = s
+	return nil
+}
+
+// String returns the last accepted input.
+func (f *funcValue) String() string { return f.last }
+
+// IsBoolFlag reports whether the callback accepts a missing argument.
+func (f *funcValue) IsBoolFlag() bool { return f.isBool }
+
+// resetDefault forgets the last accepted input.
+func (f *funcValue) resetDefault(string) { f.last = "" }
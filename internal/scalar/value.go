@@ -3,6 +3,7 @@ package scalar
 import (
 	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/utils"
+	"reflect"
 )
 
 // ScalarValue implements scalar flag parsing, formatting, and validation.
@@ -64,3 +65,12 @@ func (f *ScalarValue[T]) ApplyDefaultFinalize() {
 func (f *ScalarValue[T]) ResetParseState() {
 	utils.ResetScalarState(f.ptr, f.def, &f.changed, &f.hooks.DefaultFinalized)
 }
+
+// DefaultFinalizeApplied reports whether the default-value finalizer rewrote the value.
+func (f *ScalarValue[T]) DefaultFinalizeApplied() bool { return f.hooks.DefaultFinalized }
+
+// FormatItems returns the current value in command-line form.
+func (f *ScalarValue[T]) FormatItems() []string { return []string{f.hooks.Format(*f.ptr)} }
+
+// TypeName returns the Go type of the parsed value.
+func (f *ScalarValue[T]) TypeName() string { return reflect.TypeFor[T]().String() }
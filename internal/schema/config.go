@@ -0,0 +1,152 @@
+package schema
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+)
+
+// JSONSchemaDialect is the JSON Schema draft used by ConfigSchema.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// ConfigSchema returns a JSON Schema document that validates config files for the described flags.
+// Child commands are nested objects named after the command and dynamic groups are objects keyed
+// by instance ID, matching how config sections are read. Unknown keys are rejected so typos surface.
+func (s Schema) ConfigSchema() map[string]any {
+	doc := configObject(s.Command)
+	doc["$schema"] = JSONSchemaDialect
+	doc["title"] = s.Command.Path + " configuration"
+	return doc
+}
+
+// configObject describes the config section of one command.
+func configObject(c Command) map[string]any {
+	props := make(map[string]any)
+	for _, flags := range [][]Flag{c.Globals, c.Flags} {
+		for _, fl := range flags {
+			if !fl.DisableConfig {
+				props[fl.Name] = configProperty(fl)
+			}
+		}
+	}
+
+	for _, group := range c.Groups {
+		fields := make(map[string]any)
+		for _, fl := range group.Fields {
+			if !fl.DisableConfig {
+				fields[fl.Name] = configProperty(fl)
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		prop := map[string]any{
+			"type": "object",
+			"additionalProperties": map[string]any{
+				"type":                 "object",
+				"properties":           fields,
+				"additionalProperties": false,
+			},
+		}
+		if desc := cmp.Or(group.Title, group.Description); desc != "" {
+			prop["description"] = desc
+		}
+		props[group.Name] = prop
+	}
+
+	for _, child := range c.Commands {
+		prop := configObject(child)
+		if child.Summary != "" {
+			prop["description"] = child.Summary
+		}
+		props[child.Name] = prop
+	}
+
+	return map[string]any{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+}
+
+// configProperty describes the accepted config value of one flag.
+// Slices accept a list or a single string that is split on the flag's delimiter;
+// maps also accept an object of entries.
+func configProperty(fl Flag) map[string]any {
+	kind := jsonKind(strings.TrimPrefix(fl.Type, "[]"))
+	item := map[string]any{"type": kind}
+	if fl.Format == "bytes" {
+		// Byte sizes are read as plain numbers or with units; defaults render with units.
+		item = map[string]any{"anyOf": []any{map[string]any{"type": "integer"}, map[string]any{"type": "string"}}}
+		kind = "string"
+	}
+	if len(fl.Allowed) > 0 {
+		item = map[string]any{"enum": append([]string(nil), fl.Allowed...)}
+	}
+
+	prop := item
+	if valueType, ok := strings.CutPrefix(fl.Type, "map["); ok {
+		// Maps accept an object of entries besides the list and string forms of slices.
+		valueType = valueType[strings.Index(valueType, "]")+1:]
+		prop = map[string]any{
+			"anyOf": []any{
+				map[string]any{"type": "object", "additionalProperties": map[string]any{"type": jsonKind(valueType)}},
+				map[string]any{"type": "array", "items": item},
+				map[string]any{"type": "string"},
+			},
+		}
+		kind = "string"
+	} else if fl.Slice {
+		prop = map[string]any{
+			"anyOf": []any{
+				map[string]any{"type": "array", "items": item},
+				map[string]any{"type": "string"},
+			},
+		}
+		kind = "string"
+	}
+	if fl.Usage != "" {
+		prop["description"] = fl.Usage
+	}
+	if def, ok := jsonDefault(kind, fl.Default); ok {
+		prop["default"] = def
+	}
+	if fl.Deprecated != "" {
+		prop["deprecated"] = true
+	}
+	return prop
+}
+
+// jsonKind maps a Go type name to the JSON type a config file uses for it.
+func jsonKind(typeName string) string {
+	switch typeName {
+	case "bool":
+		return "boolean"
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "counter":
+		return "integer"
+	case "float32", "float64":
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+// jsonDefault converts a command-line default into a JSON value of the given kind.
+func jsonDefault(kind, def string) (any, bool) {
+	if def == "" {
+		return nil, false
+	}
+	switch kind {
+	case "boolean":
+		b, err := strconv.ParseBool(def)
+		return b, err == nil
+	case "integer":
+		n, err := strconv.ParseInt(def, 10, 64)
+		return n, err == nil
+	case "number":
+		n, err := strconv.ParseFloat(def, 64)
+		return n, err == nil
+	default:
+		return def, true
+	}
+}
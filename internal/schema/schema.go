@@ -0,0 +1,88 @@
+// Package schema describes the flag and command model as a stable, JSON-serializable structure.
+package schema
+
+// Version is the current schema format version. It changes only when fields are renamed,
+// removed or change meaning; new optional fields keep the version.
+const Version = 1
+
+// Schema is the versioned description of a command tree or a single flag set.
+type Schema struct {
+	Version int     `json:"version"` // Schema format version.
+	Command Command `json:"command"` // Root command or flag set.
+}
+
+// Command describes one command or flag set.
+type Command struct {
+	Name        string           `json:"name"`                  // Command segment name.
+	Aliases     []string         `json:"aliases,omitempty"`     // Alternative command names.
+	Path        string           `json:"path"`                  // Full command path, starting with the program name.
+	Summary     string           `json:"summary,omitempty"`     // One-line summary.
+	Description string           `json:"description,omitempty"` // Text shown before the flags.
+	Note        string           `json:"note,omitempty"`        // Text shown after the flags.
+	Authors     string           `json:"authors,omitempty"`     // Authors block.
+	Version     string           `json:"version,omitempty"`     // Version printed by --version.
+	EnvPrefix   string           `json:"envPrefix,omitempty"`   // Environment variable prefix.
+	Deprecated  string           `json:"deprecated,omitempty"`  // Deprecation notice.
+	Hidden      bool             `json:"hidden,omitempty"`      // Hidden from help and completion.
+	Flags       []Flag           `json:"flags,omitempty"`       // Flags local to this command.
+	Globals     []Flag           `json:"globals,omitempty"`     // Persistent flags inherited by the subtree.
+	Groups      []Group          `json:"groups,omitempty"`      // Dynamic groups.
+	OneOf       []OneOfGroup     `json:"oneOf,omitempty"`       // Mutually exclusive groups.
+	AllOrNone   []AllOrNoneGroup `json:"allOrNone,omitempty"`   // Require-together groups.
+	Commands    []Command        `json:"commands,omitempty"`    // Child commands in registration order.
+}
+
+// Flag describes a static flag or a dynamic group field.
+type Flag struct {
+	Name          string   `json:"name"`                    // Long name without dashes.
+	Short         string   `json:"short,omitempty"`         // Short alias without dash.
+	Usage         string   `json:"usage,omitempty"`         // Flag description.
+	Type          string   `json:"type"`                    // Go type (e.g. "int", "[]string", "time.Duration"); "counter" or "value" for special kinds.
+	Format        string   `json:"format,omitempty"`        // Value syntax beyond the Go type; "bytes" for sizes such as "10MiB".
+	Default       string   `json:"default,omitempty"`       // Default in command-line form.
+	Placeholder   string   `json:"placeholder,omitempty"`   // Value placeholder shown in help.
+	EnvKey        string   `json:"envKey,omitempty"`        // Environment key; dynamic fields use {ID} for the instance.
+	Section       string   `json:"section,omitempty"`       // Help section.
+	Allowed       []string `json:"allowed,omitempty"`       // Allowed values.
+	Requires      []string `json:"requires,omitempty"`      // Flags that must be set together with this one.
+	OneOf         []string `json:"oneOf,omitempty"`         // Names of one-of groups this flag belongs to.
+	AllOrNone     string   `json:"allOrNone,omitempty"`     // Name of the all-or-none group this flag belongs to.
+	Deprecated    string   `json:"deprecated,omitempty"`    // Deprecation notice.
+	Slice         bool     `json:"slice,omitempty"`         // Accepts repeated values.
+	Strict        bool     `json:"strict,omitempty"`        // Boolean that requires an explicit true/false.
+	Required      bool     `json:"required,omitempty"`      // Must be set.
+	Hidden        bool     `json:"hidden,omitempty"`        // Hidden from help.
+	DisableEnv    bool     `json:"disableEnv,omitempty"`    // Never read from the environment.
+	DisableConfig bool     `json:"disableConfig,omitempty"` // Never read from config files.
+}
+
+// Group describes a dynamic group addressed as --name.<ID>.field.
+type Group struct {
+	Name        string `json:"name"`                  // Group name.
+	Title       string `json:"title,omitempty"`       // Help heading.
+	Description string `json:"description,omitempty"` // Text shown before the fields.
+	Note        string `json:"note,omitempty"`        // Text shown after the fields.
+	Placeholder string `json:"placeholder,omitempty"` // Placeholder for the instance ID.
+	Hidden      bool   `json:"hidden,omitempty"`      // Hidden from help.
+	Fields      []Flag `json:"fields,omitempty"`      // Fields of each instance.
+}
+
+// OneOfGroup describes a set of flags of which at most one may be set.
+type OneOfGroup struct {
+	Name     string   `json:"name"`               // Group name.
+	Title    string   `json:"title,omitempty"`    // Help heading.
+	Required bool     `json:"required,omitempty"` // Exactly one member must be set.
+	Hidden   bool     `json:"hidden,omitempty"`   // Hidden from help.
+	Flags    []string `json:"flags,omitempty"`    // Member flag names.
+	Groups   []string `json:"groups,omitempty"`   // All-or-none groups that count as one member each.
+}
+
+// AllOrNoneGroup describes a set of flags that must be set together.
+type AllOrNoneGroup struct {
+	Name     string   `json:"name"`               // Group name.
+	Title    string   `json:"title,omitempty"`    // Help heading.
+	Required bool     `json:"required,omitempty"` // At least one member must be set.
+	Hidden   bool     `json:"hidden,omitempty"`   // Hidden from help.
+	Flags    []string `json:"flags,omitempty"`    // Member flag names, including those of nested groups.
+	Groups   []string `json:"groups,omitempty"`   // Nested all-or-none groups.
+}
@@ -2,7 +2,7 @@ package slice
 
 import (
 	"fmt"
-	"strings"
+	"reflect"
 
 	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/utils"
@@ -70,7 +70,7 @@ func (f *SliceValue[T]) Default() string {
 	for _, v := range f.def {
 		out = append(out, f.hooks.Format(v))
 	}
-	return strings.Join(out, f.input.Delimiter)
+	return f.input.Join(out)
 }
 
 // Changed returns true if the value was changed.
@@ -108,3 +108,24 @@ func (v *SliceValue[T]) IsSlice() {}
 func (v *SliceValue[T]) ResetParseState() {
 	utils.ResetSliceState(v.ptr, v.def, &v.changed, &v.hooks.DefaultFinalized)
 }
+
+// DefaultFinalizeApplied reports whether the default-value finalizer rewrote the value.
+func (v *SliceValue[T]) DefaultFinalizeApplied() bool { return v.hooks.DefaultFinalized }
+
+// FormatItems returns each current item in command-line form.
+func (v *SliceValue[T]) FormatItems() []string {
+	out := make([]string, 0, len(*v.ptr))
+	for _, item := range *v.ptr {
+		out = append(out, v.hooks.Format(item))
+	}
+	return out
+}
+
+// ItemDelimiter returns the separator used to split and join items.
+func (v *SliceValue[T]) ItemDelimiter() string { return v.input.Delimiter }
+
+// QuoteItem quotes an item for quoted splitting; other items are returned unchanged.
+func (v *SliceValue[T]) QuoteItem(item string) string { return v.input.QuoteItem(item) }
+
+// TypeName returns the Go type of the parsed slice.
+func (v *SliceValue[T]) TypeName() string { return reflect.TypeFor[[]T]().String() }
@@ -0,0 +1,88 @@
+// Package suggest finds close matches for mistyped flag and command names.
+package suggest
+
+import (
+	"slices"
+	"strings"
+)
+
+// DefaultDistance is the default maximum edit distance for suggestions.
+const DefaultDistance = 2
+
+// Candidate pairs a name compared against the input with the text shown to the user.
+type Candidate struct {
+	Name    string // Name compared against the input.
+	Display string // Text suggested to the user (e.g. "--verbose").
+}
+
+// Closest returns the displays of candidates within maxDistance edits of input, closest first.
+// Candidates that share nothing with the input (distance equal to its length) are never suggested,
+// and a maxDistance below 1 disables suggestions.
+func Closest(input string, candidates []Candidate, maxDistance int) []string {
+	if maxDistance < 1 || input == "" {
+		return nil
+	}
+
+	type match struct {
+		display  string
+		distance int
+	}
+	var matches []match
+	limit := min(maxDistance, len([]rune(input))-1)
+	for _, c := range candidates {
+		d := Distance(strings.ToLower(input), strings.ToLower(c.Name))
+		if d > limit || slices.ContainsFunc(matches, func(m match) bool { return m.display == c.Display }) {
+			continue
+		}
+		matches = append(matches, match{display: c.Display, distance: d})
+	}
+	slices.SortStableFunc(matches, func(a, b match) int { return a.distance - b.distance })
+
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m.display
+	}
+	return out
+}
+
+// Distance returns the optimal string alignment distance between a and b:
+// insertions, deletions, substitutions and adjacent transpositions each cost one edit.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
+
+// Hint renders suggestions as " (did you mean X?)", " (did you mean X or Y?)" and so on,
+// or returns "" when there are none.
+func Hint(suggestions []string) string {
+	switch len(suggestions) {
+	case 0:
+		return ""
+	case 1:
+		return " (did you mean " + suggestions[0] + "?)"
+	default:
+		last := len(suggestions) - 1
+		return " (did you mean " + strings.Join(suggestions[:last], ", ") + " or " + suggestions[last] + "?)"
+	}
+}
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ByteUnits selects the unit system used to render byte sizes.
+type ByteUnits int
+
+const (
+	ByteUnitsAuto  ByteUnits = iota // Shortest exact form in SI or IEC units
+	ByteUnitsIEC                    // Powers of 1024: KiB, MiB, GiB, ...
+	ByteUnitsSI                     // Powers of 1000: KB, MB, GB, ...
+	ByteUnitsPlain                  // Plain byte count without a unit
+)
+
+type byteUnit struct {
+	name string
+	size uint64
+}
+
+var (
+	iecUnits = []byteUnit{{"EiB", 1 << 60}, {"PiB", 1 << 50}, {"TiB", 1 << 40}, {"GiB", 1 << 30}, {"MiB", 1 << 20}, {"KiB", 1 << 10}}
+	siUnits  = []byteUnit{{"EB", 1e18}, {"PB", 1e15}, {"TB", 1e12}, {"GB", 1e9}, {"MB", 1e6}, {"KB", 1e3}}
+)
+
+// byteSuffixes maps upper-cased suffixes to their multiplier; single letters are SI.
+var byteSuffixes = map[string]uint64{
+	"": 1, "B": 1,
+	"K": 1e3, "KB": 1e3, "M": 1e6, "MB": 1e6, "G": 1e9, "GB": 1e9,
+	"T": 1e12, "TB": 1e12, "P": 1e15, "PB": 1e15, "E": 1e18, "EB": 1e18,
+	"KI": 1 << 10, "KIB": 1 << 10, "MI": 1 << 20, "MIB": 1 << 20, "GI": 1 << 30, "GIB": 1 << 30,
+	"TI": 1 << 40, "TIB": 1 << 40, "PI": 1 << 50, "PIB": 1 << 50, "EI": 1 << 60, "EIB": 1 << 60,
+}
+
+// ParseBytes string → uint64; accepts SI (KB, MB, ...) and IEC (KiB, MiB, ...) suffixes case-insensitively,
+// with optional fractions ("1.5G") as long as the result is a whole number of bytes.
+func ParseBytes(s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+	end := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != '_' })
+	if end < 0 {
+		end = len(s)
+	}
+	number, suffix := s[:end], strings.ToUpper(strings.TrimSpace(s[end:]))
+	if number == "" {
+		return 0, fmt.Errorf("invalid byte size %q: missing number", s)
+	}
+	mult, ok := byteSuffixes[suffix]
+	if !ok {
+		return 0, fmt.Errorf("invalid byte size %q: unknown unit %q", s, s[end:])
+	}
+
+	if !strings.Contains(number, ".") {
+		n, err := strconv.ParseUint(strings.ReplaceAll(number, "_", ""), 10, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return 0, fmt.Errorf("invalid byte size %q: value out of range", s)
+			}
+			return 0, fmt.Errorf("invalid byte size %q: invalid number", s)
+		}
+		if n > math.MaxUint64/mult {
+			return 0, fmt.Errorf("invalid byte size %q: value out of range", s)
+		}
+		return n * mult, nil
+	}
+
+	r, ok := new(big.Rat).SetString(strings.ReplaceAll(number, "_", ""))
+	if !ok {
+		return 0, fmt.Errorf("invalid byte size %q: invalid number", s)
+	}
+	r.Mul(r, new(big.Rat).SetUint64(mult))
+	if !r.IsInt() {
+		return 0, fmt.Errorf("invalid byte size %q: not a whole number of bytes", s)
+	}
+	if !r.Num().IsUint64() {
+		return 0, fmt.Errorf("invalid byte size %q: value out of range", s)
+	}
+	return r.Num().Uint64(), nil
+}
+
+// FormatBytes uint64 → string in the shortest exact SI or IEC form (e.g. "10MiB", "1.5GB").
+func FormatBytes(b uint64) string { return FormatBytesIn(b, ByteUnitsAuto) }
+
+// FormatBytesIn renders b in the given unit system using the largest unit that keeps
+// the value exact to three decimals, so the result parses back to b.
+func FormatBytesIn(b uint64, units ByteUnits) string {
+	var candidates []byteUnit
+	switch units {
+	case ByteUnitsPlain:
+		return strconv.FormatUint(b, 10)
+	case ByteUnitsIEC:
+		candidates = iecUnits
+	case ByteUnitsSI:
+		candidates = siUnits
+	default:
+		candidates = mergeUnits(iecUnits, siUnits)
+	}
+
+	for _, unit := range candidates {
+		if b < unit.size {
+			continue
+		}
+		r := new(big.Rat).SetFrac(new(big.Int).SetUint64(b), new(big.Int).SetUint64(unit.size))
+		if new(big.Rat).Mul(r, big.NewRat(1000, 1)).IsInt() {
+			return trimDecimals(r.FloatString(3)) + unit.name
+		}
+	}
+	return strconv.FormatUint(b, 10) + "B"
+}
+
+// mergeUnits interleaves two unit lists ordered from largest to smallest.
+func mergeUnits(a, b []byteUnit) []byteUnit {
+	out := make([]byteUnit, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if a[0].size >= b[0].size {
+			out, a = append(out, a[0]), a[1:]
+		} else {
+			out, b = append(out, b[0]), b[1:]
+		}
+	}
+	return append(append(out, a...), b...)
+}
+
+// trimDecimals drops trailing zeros and a trailing dot from a fixed-point number.
+func trimDecimals(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+)
+
+// ParseText decodes s into a new T through its pointer's UnmarshalText.
+func ParseText[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](s string) (T, error) {
+	var v T
+	err := PT(&v).UnmarshalText([]byte(s))
+	return v, err
+}
+
+// FormatText renders v through encoding.TextMarshaler, falling back to fmt.Sprint.
+func FormatText[T any](v T) string {
+	m, ok := any(v).(encoding.TextMarshaler)
+	if !ok {
+		m, ok = any(&v).(encoding.TextMarshaler)
+	}
+	if !ok {
+		return fmt.Sprint(v)
+	}
+	b, err := m.MarshalText()
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// ParseFunc adapts a callback to a parser that keeps the raw input on success.
+func ParseFunc(fn func(string) error) func(string) (string, error) {
+	return func(s string) (string, error) {
+		if err := fn(s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+}
+
+// ParseFlagValue builds a parser that sets a fresh flag.Value per input.
+func ParseFlagValue(newValue func() flag.Value) func(string) (flag.Value, error) {
+	return func(s string) (flag.Value, error) {
+		v := newValue()
+		if err := v.Set(s); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+// FormatFlagValue flag.Value → string
+func FormatFlagValue(v flag.Value) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
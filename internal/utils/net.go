@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// IPValue lists the address and network types accepted by the IP family validators.
+type IPValue interface {
+	net.IP | *net.IPNet | netip.Addr | netip.AddrPort | netip.Prefix
+}
+
+// ParseIP string → net.IP
+func ParseIP(s string) (net.IP, error) {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q", s)
+	}
+	return ip, nil
+}
+
+// FormatIP net.IP → string
+func FormatIP(ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
+
+// ParseIPv4Mask string → net.IPMask
+func ParseIPv4Mask(s string) (net.IPMask, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("invalid IP mask: %s", s)
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP format: %s", s)
+	}
+	return net.IPMask(ip.To4()), nil
+}
+
+// FormatIPv4Mask net.IPMask → string
+func FormatIPv4Mask(ip net.IPMask) string { return ip.String() }
+
+// ParseTCPAddr string → *net.TCPAddr
+func ParseTCPAddr(s string) (*net.TCPAddr, error) {
+	addr, err := net.ResolveTCPAddr("tcp", s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TCP address %q: %w", s, err)
+	}
+	return addr, nil
+}
+
+// FormatTCPAddr *net.TCPAddr → string
+func FormatTCPAddr(addr *net.TCPAddr) string {
+	if addr == nil {
+		return ""
+	}
+	return addr.String()
+}
+
+// ParseAddr string → netip.Addr
+func ParseAddr(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid IP address %q", s)
+	}
+	return addr, nil
+}
+
+// FormatAddr netip.Addr → string; the zero Addr formats as "".
+func FormatAddr(addr netip.Addr) string {
+	if !addr.IsValid() {
+		return ""
+	}
+	return addr.String()
+}
+
+// ParseAddrPort string → netip.AddrPort, e.g. "10.0.0.1:80" or "[::1]:443".
+func ParseAddrPort(s string) (netip.AddrPort, error) {
+	ap, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("invalid address and port %q", s)
+	}
+	return ap, nil
+}
+
+// FormatAddrPort netip.AddrPort → string; the zero AddrPort formats as "".
+func FormatAddrPort(ap netip.AddrPort) string {
+	if !ap.IsValid() {
+		return ""
+	}
+	return ap.String()
+}
+
+// ParsePrefix string → netip.Prefix; host bits are kept as given.
+func ParsePrefix(s string) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR prefix %q", s)
+	}
+	return p, nil
+}
+
+// FormatPrefix netip.Prefix → string; the zero Prefix formats as "".
+func FormatPrefix(p netip.Prefix) string {
+	if !p.IsValid() {
+		return ""
+	}
+	return p.String()
+}
+
+// ParseIPNet string → *net.IPNet; host bits are masked off like net.ParseCIDR.
+func ParseIPNet(s string) (*net.IPNet, error) {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR prefix %q", s)
+	}
+	return n, nil
+}
+
+// FormatIPNet *net.IPNet → string
+func FormatIPNet(n *net.IPNet) string {
+	if n == nil {
+		return ""
+	}
+	return n.String()
+}
+
+// RequireIPv4 rejects values whose address is not IPv4; IPv4-mapped IPv6 addresses count as IPv4.
+func RequireIPv4[T IPValue](v T) error {
+	if !ipAddrOf(v).Unmap().Is4() {
+		return errors.New("must be an IPv4 address")
+	}
+	return nil
+}
+
+// RequireIPv6 rejects values whose address is IPv4 or IPv4-mapped IPv6.
+func RequireIPv6[T IPValue](v T) error {
+	addr := ipAddrOf(v)
+	if !addr.Is6() || addr.Is4In6() {
+		return errors.New("must be an IPv6 address")
+	}
+	return nil
+}
+
+// ipAddrOf returns the address part of an IP value; nil values yield the zero Addr.
+func ipAddrOf(v any) netip.Addr {
+	var addr netip.Addr
+	switch val := v.(type) {
+	case net.IP:
+		addr, _ = netip.AddrFromSlice(val)
+	case *net.IPNet:
+		if val != nil {
+			addr, _ = netip.AddrFromSlice(val.IP)
+		}
+	case netip.Addr:
+		addr = val
+	case netip.AddrPort:
+		addr = val.Addr()
+	case netip.Prefix:
+		addr = val.Addr()
+	}
+	return addr
+}
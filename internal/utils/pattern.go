@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"runtime"
+	"sync"
+	"text/template"
+	"weak"
+)
+
+// ParseRegexp string → *regexp.Regexp
+func ParseRegexp(s string) (*regexp.Regexp, error) { return regexp.Compile(s) }
+
+// FormatRegexp *regexp.Regexp → source pattern
+func FormatRegexp(re *regexp.Regexp) string {
+	if re == nil {
+		return ""
+	}
+	return re.String()
+}
+
+// ParseGlob checks s with path.Match and returns it unchanged.
+func ParseGlob(s string) (string, error) {
+	if _, err := path.Match(s, ""); err != nil {
+		return "", fmt.Errorf("invalid glob pattern %q: %w", s, err)
+	}
+	return s, nil
+}
+
+// TemplateFuncs returns a parser compiling text templates under the given name and a formatter
+// returning the source each parsed template was compiled from, so trim markers, comments and
+// {{define}} blocks survive help, dumps and env round-trips. Templates the parser did not
+// produce, such as defaults built by the caller, fall back to FormatTemplate.
+// Sources are held only while their template is reachable.
+func TemplateFuncs(name string) (parse func(string) (*template.Template, error), format func(*template.Template) string) {
+	var (
+		mu      sync.Mutex
+		sources = make(map[weak.Pointer[template.Template]]string)
+	)
+	parse = func(s string) (*template.Template, error) {
+		t, err := template.New(name).Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		key := weak.Make(t)
+		mu.Lock()
+		sources[key] = s
+		mu.Unlock()
+		runtime.AddCleanup(t, func(key weak.Pointer[template.Template]) {
+			mu.Lock()
+			delete(sources, key)
+			mu.Unlock()
+		}, key)
+		return t, nil
+	}
+	format = func(t *template.Template) string {
+		mu.Lock()
+		src, ok := sources[weak.Make(t)]
+		mu.Unlock()
+		if ok {
+			return src
+		}
+		return FormatTemplate(t)
+	}
+	return parse, format
+}
+
+// FormatTemplate *template.Template → template source as reconstructed from its parse tree
+func FormatTemplate(t *template.Template) string {
+	if t == nil || t.Tree == nil || t.Tree.Root == nil {
+		return ""
+	}
+	return t.Tree.Root.String()
+}
@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Pseudo layouts for Unix epoch timestamps.
+const (
+	TimeLayoutUnix      = "unix"      // Seconds since the Unix epoch, e.g. 1700000000
+	TimeLayoutUnixMilli = "unixmilli" // Milliseconds since the Unix epoch, e.g. 1700000000000
+)
+
+// TimeConfig controls how time flags parse and render values.
+type TimeConfig struct {
+	Layouts  []string         // Layouts tried in order; the first one formats values (default: RFC3339)
+	Location *time.Location   // Location for layouts without a zone (default: UTC)
+	Now      func() time.Time // Clock for relative expressions (default: time.Now)
+	Parent   *TimeConfig      // Settings used where this config sets none, e.g. the parent command's
+}
+
+// TimeRange is an inclusive span between two points in time.
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains reports whether t lies within the range, bounds included.
+func (r TimeRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && !t.After(r.End)
+}
+
+// Duration returns the length of the range.
+func (r TimeRange) Duration() time.Duration { return r.End.Sub(r.Start) }
+
+// ParseTime parses an absolute time using the configured layouts, or a relative
+// expression such as "now", "now-2h" or "-30m" resolved against the configured clock.
+func (c *TimeConfig) ParseTime(s string) (time.Time, error) {
+	if t, ok, err := c.parseRelative(s); ok {
+		return t, err
+	}
+	for _, layout := range c.layouts() {
+		if t, err := c.parseLayout(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: expected %s or a relative time such as now-2h", s, strings.Join(c.layouts(), ", "))
+}
+
+// FormatTime renders t with the first configured layout.
+func (c *TimeConfig) FormatTime(t time.Time) string {
+	switch layout := c.layouts()[0]; layout {
+	case TimeLayoutUnix:
+		return strconv.FormatInt(t.Unix(), 10)
+	case TimeLayoutUnixMilli:
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	default:
+		return t.Format(layout)
+	}
+}
+
+// ParseTimeRange parses "start..end"; each side accepts everything ParseTime does.
+func (c *TimeConfig) ParseTimeRange(s string) (TimeRange, error) {
+	rawStart, rawEnd, ok := strings.Cut(s, "..")
+	if !ok {
+		return TimeRange{}, fmt.Errorf("invalid time range %q: expected start..end", s)
+	}
+	start, err := c.ParseTime(rawStart)
+	if err != nil {
+		return TimeRange{}, fmt.Errorf("invalid range start: %w", err)
+	}
+	end, err := c.ParseTime(rawEnd)
+	if err != nil {
+		return TimeRange{}, fmt.Errorf("invalid range end: %w", err)
+	}
+	if end.Before(start) {
+		return TimeRange{}, fmt.Errorf("invalid time range %q: end is before start", s)
+	}
+	return TimeRange{Start: start, End: end}, nil
+}
+
+// FormatTimeRange renders r as "start..end"; the zero range formats as "".
+func (c *TimeConfig) FormatTimeRange(r TimeRange) string {
+	if r.Start.IsZero() && r.End.IsZero() {
+		return ""
+	}
+	return c.FormatTime(r.Start) + ".." + c.FormatTime(r.End)
+}
+
+// parseRelative resolves "now", "now±<duration>" and "±<duration>"; ok reports whether s used that form.
+func (c *TimeConfig) parseRelative(s string) (time.Time, bool, error) {
+	rest, hasNow := strings.CutPrefix(s, "now")
+	switch {
+	case hasNow && rest == "":
+		return c.now(), true, nil
+	case hasNow:
+		if rest[0] != '+' && rest[0] != '-' {
+			return time.Time{}, true, fmt.Errorf("invalid relative time %q: expected now+<duration> or now-<duration>", s)
+		}
+		d, err := time.ParseDuration(rest)
+		if err != nil {
+			return time.Time{}, true, fmt.Errorf("invalid relative time %q: %w", s, err)
+		}
+		return c.now().Add(d), true, nil
+	case strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-"):
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return time.Time{}, false, nil // May still be an epoch timestamp
+		}
+		return c.now().Add(d), true, nil
+	}
+	return time.Time{}, false, nil
+}
+
+// parseLayout parses s with one layout or epoch pseudo layout.
+func (c *TimeConfig) parseLayout(layout, s string) (time.Time, error) {
+	switch layout {
+	case TimeLayoutUnix, TimeLayoutUnixMilli:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if layout == TimeLayoutUnix {
+			return time.Unix(n, 0).In(c.location()), nil
+		}
+		return time.UnixMilli(n).In(c.location()), nil
+	default:
+		return time.ParseInLocation(layout, s, c.location())
+	}
+}
+
+func (c *TimeConfig) layouts() []string {
+	if len(c.Layouts) == 0 {
+		if c.Parent != nil {
+			return c.Parent.layouts()
+		}
+		return []string{time.RFC3339}
+	}
+	return c.Layouts
+}
+
+func (c *TimeConfig) location() *time.Location {
+	if c.Location == nil {
+		if c.Parent != nil {
+			return c.Parent.location()
+		}
+		return time.UTC
+	}
+	return c.Location
+}
+
+func (c *TimeConfig) now() time.Time {
+	if c.Now == nil {
+		if c.Parent != nil {
+			return c.Parent.now()
+		}
+		return time.Now()
+	}
+	return c.Now()
+}
+
+// ParseLocation string → *time.Location, e.g. "Europe/Zurich", "UTC" or "Local".
+func ParseLocation(s string) (*time.Location, error) {
+	if s == "" {
+		return nil, errors.New("empty time zone")
+	}
+	loc, err := time.LoadLocation(s)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", s)
+	}
+	return loc, nil
+}
+
+// FormatLocation *time.Location → string
+func FormatLocation(loc *time.Location) string {
+	if loc == nil {
+		return ""
+	}
+	return loc.String()
+}
@@ -1,12 +1,13 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
-	"net"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
-	"time"
+	"unicode"
 )
 
 // AllowOnly returns a validator function that permits only values from the given list.
@@ -63,23 +64,6 @@ func ParseString(s string) (string, error) { return s, nil }
 // FormatString string → string
 func FormatString(s string) string { return s }
 
-// ParseTCPAddr string → *net.TCPAddr
-func ParseTCPAddr(s string) (*net.TCPAddr, error) {
-	addr, err := net.ResolveTCPAddr("tcp", s)
-	if err != nil {
-		return nil, fmt.Errorf("invalid TCP address %q: %w", s, err)
-	}
-	return addr, nil
-}
-
-// FormatTCPAddr *net.TCPAddr → string
-func FormatTCPAddr(addr *net.TCPAddr) string {
-	if addr == nil {
-		return ""
-	}
-	return addr.String()
-}
-
 // ParseDuration string → time.Duration
 func ParseFloat64(s string) (float64, error) { return strconv.ParseFloat(s, 64) }
 
@@ -95,42 +79,63 @@ func ParseFloat32(s string) (float32, error) {
 // FormatFloat32 float32 → string
 func FormatFloat32(f float32) string { return strconv.FormatFloat(float64(f), 'f', -1, 32) }
 
-// ParseIP net.IP → string
-func ParseIP(s string) (net.IP, error) { return net.ParseIP(s), nil }
-
-// FormatIP net.IP → string
-func FormatIP(ip net.IP) string { return ip.String() }
+// Unsigned lists the unsigned integer types with built-in flag support.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
 
-// ParseIPv4Mask string → net.IPMask
-func ParseIPv4Mask(s string) (net.IPMask, error) {
-	parts := strings.Split(s, ".")
-	if len(parts) != 4 {
-		return nil, fmt.Errorf("invalid IP mask: %s", s)
+// ParseUnsigned string → unsigned integer of T's size; accepts 0x/0o/0b prefixes and _ separators.
+// Without a prefix the value is decimal, so zero-padded input such as "010" is 10, not octal 8.
+func ParseUnsigned[T Unsigned](s string) (T, error) {
+	digits := s
+	if len(s) < 2 || !strings.ContainsRune("xXoObB", rune(s[1])) {
+		// Drop leading zeros, with the separator after each, so base 0 does not read octal.
+		for len(digits) > 1 && digits[0] == '0' {
+			next := digits[1:]
+			if next[0] == '_' {
+				next = next[1:]
+			}
+			if next == "" {
+				break
+			}
+			digits = next
+		}
 	}
-	ip := net.ParseIP(s)
-	if ip == nil {
-		return nil, fmt.Errorf("invalid IP format: %s", s)
+	v, err := strconv.ParseUint(digits, 0, reflect.TypeFor[T]().Bits())
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		numErr.Num = s
 	}
-	return net.IPMask(ip.To4()), nil
+	return T(v), err
 }
 
-// FormatIPv4Mask net.IPMask → string
-func FormatIPv4Mask(ip net.IPMask) string { return ip.String() }
-
-// ParseBytes string → uint64
-func ParseBytes(s string) (uint64, error) { return strconv.ParseUint(s, 10, 64) }
-
-// FormatBytes uint64 → string
-func FormatBytes(b uint64) string { return strconv.FormatUint(b, 10) }
+// FormatUnsigned unsigned integer → decimal string
+func FormatUnsigned[T Unsigned](v T) string { return strconv.FormatUint(uint64(v), 10) }
 
 // ParseFile string → *os.File
 func ParseFile(s string) (*os.File, error) { return os.Open(s) }
 
 // FormatFile *os.File → string
-func FormatFile(f *os.File) string { return f.Name() }
-
-// ParseTime string → time.Time
-func ParseTime(s string) (time.Time, error) { return time.Parse(time.RFC3339, s) }
+func FormatFile(f *os.File) string {
+	if f == nil {
+		return ""
+	}
+	return f.Name()
+}
 
-// FormatTime time.Time → string
-func FormatTime(t time.Time) string { return t.Format(time.RFC3339) }
+// KebabCase converts a Go field name such as "ListenAddr" or "TLSCert" to "listen-addr" or "tls-cert".
+func KebabCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && !unicode.IsUpper(runes[i-1])
+			nextLower := i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])
+			if prevLower || nextLower {
+				b.WriteByte('-')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,51 @@
+package tinyflags
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/containeroo/tinyflags/internal/docs"
+)
+
+// ManHeader holds the .TH fields of generated man pages.
+// Section defaults to "1" and Source to the program name plus its version.
+type ManHeader = docs.ManHeader
+
+// GenManPage writes the roff man page for c.
+func (c *Command) GenManPage(w io.Writer, header ManHeader) error {
+	if w == nil {
+		return nil
+	}
+	return docs.Man(w, c.docPage(), header)
+}
+
+// GenManTree writes one man page per visible command of the subtree rooted at c into dir.
+// Files are named after the command path with dashes, e.g. "app-serve.1".
+func (c *Command) GenManTree(dir string, header ManHeader) error {
+	section := header.Section
+	if section == "" {
+		section = "1"
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("man: %w", err)
+	}
+
+	var err error
+	c.docPage().Walk(func(page *docs.Page) {
+		if err != nil {
+			return
+		}
+		var buf bytes.Buffer
+		if err = docs.Man(&buf, page, header); err != nil {
+			return
+		}
+		path := filepath.Join(dir, page.FileName()+"."+section)
+		if err = os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			err = fmt.Errorf("man: %w", err)
+		}
+	})
+	return err
+}
@@ -0,0 +1,13 @@
+package tinyflags
+
+import "github.com/containeroo/tinyflags/internal/mapflag"
+
+// StringMapVar defines a map[string]string flag (e.g. --label env=prod) and binds it to the given pointer.
+func (f *FlagSet) StringMapVar(ptr *map[string]string, name string, def map[string]string, usage string) *mapflag.MapFlag[string, string] {
+	return f.impl.StringMapVar(ptr, name, def, usage)
+}
+
+// StringMap defines a map[string]string flag and returns its handle.
+func (f *FlagSet) StringMap(name string, def map[string]string, usage string) *mapflag.MapFlag[string, string] {
+	return f.StringMapVar(new(map[string]string), name, def, usage)
+}
@@ -0,0 +1,15 @@
+package tinyflags
+
+import "github.com/containeroo/tinyflags/internal/utils"
+
+// IPValue lists the address and network types accepted by IPv4Only and IPv6Only:
+// net.IP, *net.IPNet, netip.Addr, netip.AddrPort and netip.Prefix.
+type IPValue = utils.IPValue
+
+// IPv4Only rejects addresses and networks that are not IPv4.
+// Use it as a validator, e.g. fs.Addr("bind", netip.Addr{}, "...").Validate(tinyflags.IPv4Only).
+func IPv4Only[T IPValue](v T) error { return utils.RequireIPv4(v) }
+
+// IPv6Only rejects addresses and networks that are IPv4 or IPv4-mapped IPv6.
+// Use it as a validator, e.g. fs.PrefixSlice("allow", nil, "...").Validate(tinyflags.IPv6Only).
+func IPv6Only[T IPValue](v T) error { return utils.RequireIPv6(v) }
@@ -0,0 +1,68 @@
+package tinyflags
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/containeroo/tinyflags/internal/positional"
+)
+
+type (
+	PositionalArg[T any] = positional.Arg[T]      // Named single positional argument
+	VariadicArg[T any]   = positional.Variadic[T] // Named positional argument collecting several values
+)
+
+// Positional defines a required positional argument of a built-in type.
+// Arguments are filled in declaration order from the values left after flag parsing.
+func Positional[T any](f *FlagSet, name, usage string) *positional.Arg[T] {
+	parse, format := builtinHooks[T](f, name)
+	return CustomPositional(f, name, usage, parse, format)
+}
+
+// OptionalPositional defines an optional positional argument of a built-in type that falls back to def.
+func OptionalPositional[T any](f *FlagSet, name string, def T, usage string) *positional.Arg[T] {
+	parse, format := builtinHooks[T](f, name)
+	return CustomOptionalPositional(f, name, def, usage, parse, format)
+}
+
+// VariadicPositional defines a trailing positional argument of a built-in type taking between
+// minVals and maxVals values; a negative maxVals means unlimited.
+func VariadicPositional[T any](f *FlagSet, name, usage string, minVals, maxVals int) *positional.Variadic[T] {
+	parse, format := builtinHooks[T](f, name)
+	return CustomVariadicPositional(f, name, usage, minVals, maxVals, parse, format)
+}
+
+// CustomPositional defines a required positional argument of a user-defined type.
+func CustomPositional[T any](f *FlagSet, name, usage string, parse ParseFunc[T], format FormatFunc[T]) *positional.Arg[T] {
+	parse, format = customHooks(name, parse, format)
+	var zero T
+	arg := positional.NewArg(name, usage, zero, false, parse, format)
+	f.impl.AddPositional(arg)
+	return arg
+}
+
+// CustomOptionalPositional defines an optional positional argument of a user-defined type.
+func CustomOptionalPositional[T any](f *FlagSet, name string, def T, usage string, parse ParseFunc[T], format FormatFunc[T]) *positional.Arg[T] {
+	parse, format = customHooks(name, parse, format)
+	arg := positional.NewArg(name, usage, def, true, parse, format)
+	f.impl.AddPositional(arg)
+	return arg
+}
+
+// CustomVariadicPositional defines a trailing variadic positional argument of a user-defined type.
+func CustomVariadicPositional[T any](f *FlagSet, name, usage string, minVals, maxVals int, parse ParseFunc[T], format FormatFunc[T]) *positional.Variadic[T] {
+	parse, format = customHooks(name, parse, format)
+	arg := positional.NewVariadic(name, usage, minVals, maxVals, parse, format)
+	f.impl.AddPositional(arg)
+	return arg
+}
+
+// builtinHooks returns the parse and format functions flags of f use for T; it panics for other types.
+func builtinHooks[T any](f *FlagSet, name string) (ParseFunc[T], FormatFunc[T]) {
+	bt, ok := builtinTypes[reflect.TypeFor[T]()]
+	if !ok || bt.hooks == nil {
+		panic(fmt.Sprintf("tinyflags: unsupported type %s for positional %q; use CustomPositional", reflect.TypeFor[T](), name))
+	}
+	parse, format := bt.hooks(f, name)
+	return parse.(ParseFunc[T]), format.(FormatFunc[T])
+}
@@ -2,8 +2,11 @@ package tinyflags
 
 import (
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
+	"regexp"
+	"text/template"
 	"time"
 
 	"github.com/containeroo/tinyflags/internal/scalar"
@@ -61,6 +64,56 @@ func (f *FlagSet) Int64(name string, def int64, usage string) *scalar.ScalarFlag
 	return f.Int64Var(new(int64), name, def, usage)
 }
 
+// UintVar defines a uint flag and binds it to the given pointer.
+func (f *FlagSet) UintVar(ptr *uint, name string, def uint, usage string) *scalar.ScalarFlag[uint] {
+	return f.impl.UintVar(ptr, name, def, usage)
+}
+
+// Uint defines a uint flag and returns its handle.
+func (f *FlagSet) Uint(name string, def uint, usage string) *scalar.ScalarFlag[uint] {
+	return f.UintVar(new(uint), name, def, usage)
+}
+
+// Uint8Var defines a uint8 flag and binds it to the given pointer.
+func (f *FlagSet) Uint8Var(ptr *uint8, name string, def uint8, usage string) *scalar.ScalarFlag[uint8] {
+	return f.impl.Uint8Var(ptr, name, def, usage)
+}
+
+// Uint8 defines a uint8 flag and returns its handle.
+func (f *FlagSet) Uint8(name string, def uint8, usage string) *scalar.ScalarFlag[uint8] {
+	return f.Uint8Var(new(uint8), name, def, usage)
+}
+
+// Uint16Var defines a uint16 flag and binds it to the given pointer.
+func (f *FlagSet) Uint16Var(ptr *uint16, name string, def uint16, usage string) *scalar.ScalarFlag[uint16] {
+	return f.impl.Uint16Var(ptr, name, def, usage)
+}
+
+// Uint16 defines a uint16 flag and returns its handle.
+func (f *FlagSet) Uint16(name string, def uint16, usage string) *scalar.ScalarFlag[uint16] {
+	return f.Uint16Var(new(uint16), name, def, usage)
+}
+
+// Uint32Var defines a uint32 flag and binds it to the given pointer.
+func (f *FlagSet) Uint32Var(ptr *uint32, name string, def uint32, usage string) *scalar.ScalarFlag[uint32] {
+	return f.impl.Uint32Var(ptr, name, def, usage)
+}
+
+// Uint32 defines a uint32 flag and returns its handle.
+func (f *FlagSet) Uint32(name string, def uint32, usage string) *scalar.ScalarFlag[uint32] {
+	return f.Uint32Var(new(uint32), name, def, usage)
+}
+
+// Uint64Var defines a uint64 flag and binds it to the given pointer.
+func (f *FlagSet) Uint64Var(ptr *uint64, name string, def uint64, usage string) *scalar.ScalarFlag[uint64] {
+	return f.impl.Uint64Var(ptr, name, def, usage)
+}
+
+// Uint64 defines a uint64 flag and returns its handle.
+func (f *FlagSet) Uint64(name string, def uint64, usage string) *scalar.ScalarFlag[uint64] {
+	return f.Uint64Var(new(uint64), name, def, usage)
+}
+
 // Bool defines a bool flag.
 // If Strict() is enabled, it must be set explicitly (--flag=true/false).
 func (f *FlagSet) Bool(name string, def bool, usage string) *scalar.BoolFlag {
@@ -143,6 +196,76 @@ func (f *FlagSet) TCPAddr(name string, def *net.TCPAddr, usage string) *scalar.S
 	return f.TCPAddrVar(new(*net.TCPAddr), name, def, usage)
 }
 
+// AddrVar defines a netip.Addr flag and binds it to the given pointer.
+func (f *FlagSet) AddrVar(ptr *netip.Addr, name string, def netip.Addr, usage string) *scalar.ScalarFlag[netip.Addr] {
+	return f.impl.AddrVar(ptr, name, def, usage)
+}
+
+// Addr defines a netip.Addr flag and returns its handle.
+func (f *FlagSet) Addr(name string, def netip.Addr, usage string) *scalar.ScalarFlag[netip.Addr] {
+	return f.AddrVar(new(netip.Addr), name, def, usage)
+}
+
+// AddrPortVar defines a netip.AddrPort flag (e.g. 10.0.0.1:80 or [::1]:443) and binds it to the given pointer.
+func (f *FlagSet) AddrPortVar(ptr *netip.AddrPort, name string, def netip.AddrPort, usage string) *scalar.ScalarFlag[netip.AddrPort] {
+	return f.impl.AddrPortVar(ptr, name, def, usage)
+}
+
+// AddrPort defines a netip.AddrPort flag and returns its handle.
+func (f *FlagSet) AddrPort(name string, def netip.AddrPort, usage string) *scalar.ScalarFlag[netip.AddrPort] {
+	return f.AddrPortVar(new(netip.AddrPort), name, def, usage)
+}
+
+// PrefixVar defines a netip.Prefix flag (e.g. 10.0.0.0/8) and binds it to the given pointer; host bits are kept.
+func (f *FlagSet) PrefixVar(ptr *netip.Prefix, name string, def netip.Prefix, usage string) *scalar.ScalarFlag[netip.Prefix] {
+	return f.impl.PrefixVar(ptr, name, def, usage)
+}
+
+// Prefix defines a netip.Prefix flag and returns its handle.
+func (f *FlagSet) Prefix(name string, def netip.Prefix, usage string) *scalar.ScalarFlag[netip.Prefix] {
+	return f.PrefixVar(new(netip.Prefix), name, def, usage)
+}
+
+// IPNetVar defines a *net.IPNet flag (e.g. 10.0.0.0/8) and binds it to the given pointer; host bits are masked off.
+func (f *FlagSet) IPNetVar(ptr **net.IPNet, name string, def *net.IPNet, usage string) *scalar.ScalarFlag[*net.IPNet] {
+	return f.impl.IPNetVar(ptr, name, def, usage)
+}
+
+// IPNet defines a *net.IPNet flag and returns its handle.
+func (f *FlagSet) IPNet(name string, def *net.IPNet, usage string) *scalar.ScalarFlag[*net.IPNet] {
+	return f.IPNetVar(new(*net.IPNet), name, def, usage)
+}
+
+// RegexpVar defines a *regexp.Regexp flag compiled while parsing and binds it to the given pointer.
+func (f *FlagSet) RegexpVar(ptr **regexp.Regexp, name string, def *regexp.Regexp, usage string) *scalar.ScalarFlag[*regexp.Regexp] {
+	return f.impl.RegexpVar(ptr, name, def, usage)
+}
+
+// Regexp defines a *regexp.Regexp flag compiled while parsing and returns its handle.
+func (f *FlagSet) Regexp(name string, def *regexp.Regexp, usage string) *scalar.ScalarFlag[*regexp.Regexp] {
+	return f.RegexpVar(new(*regexp.Regexp), name, def, usage)
+}
+
+// GlobVar defines a string glob pattern flag validated with path.Match and binds it to the given pointer.
+func (f *FlagSet) GlobVar(ptr *string, name string, def string, usage string) *scalar.ScalarFlag[string] {
+	return f.impl.GlobVar(ptr, name, def, usage)
+}
+
+// Glob defines a string glob pattern flag validated with path.Match and returns its handle.
+func (f *FlagSet) Glob(name string, def string, usage string) *scalar.ScalarFlag[string] {
+	return f.GlobVar(new(string), name, def, usage)
+}
+
+// TemplateVar defines a *template.Template flag and binds it to the given pointer; values are parsed with text/template under the flag's name.
+func (f *FlagSet) TemplateVar(ptr **template.Template, name string, def *template.Template, usage string) *scalar.ScalarFlag[*template.Template] {
+	return f.impl.TemplateVar(ptr, name, def, usage)
+}
+
+// Template defines a *template.Template flag and returns its handle; values are parsed with text/template under the flag's name.
+func (f *FlagSet) Template(name string, def *template.Template, usage string) *scalar.ScalarFlag[*template.Template] {
+	return f.TemplateVar(new(*template.Template), name, def, usage)
+}
+
 // URLVar defines a *url.URL flag and binds it to the given pointer.
 func (f *FlagSet) URLVar(ptr **url.URL, name string, def *url.URL, usage string) *scalar.ScalarFlag[*url.URL] {
 	return f.impl.URLVar(ptr, name, def, usage)
@@ -164,6 +287,7 @@ func (f *FlagSet) File(name string, def *os.File, usage string) *scalar.ScalarFl
 }
 
 // TimeVar defines a time.Time flag and binds it to the given pointer.
+// Values use the layouts from SetTimeLayouts (default: RFC3339) or a relative form: now, now-2h, +30m.
 func (f *FlagSet) TimeVar(ptr *time.Time, name string, def time.Time, usage string) *scalar.ScalarFlag[time.Time] {
 	return f.impl.TimeVar(ptr, name, def, usage)
 }
@@ -173,6 +297,27 @@ func (f *FlagSet) Time(name string, def time.Time, usage string) *scalar.ScalarF
 	return f.TimeVar(new(time.Time), name, def, usage)
 }
 
+// TimeRangeVar defines a TimeRange flag parsed from "start..end" and binds it to the given pointer.
+// Both ends accept the same input as Time flags; an end before the start is rejected.
+func (f *FlagSet) TimeRangeVar(ptr *TimeRange, name string, def TimeRange, usage string) *scalar.ScalarFlag[TimeRange] {
+	return f.impl.TimeRangeVar(ptr, name, def, usage)
+}
+
+// TimeRange defines a TimeRange flag and returns its handle.
+func (f *FlagSet) TimeRange(name string, def TimeRange, usage string) *scalar.ScalarFlag[TimeRange] {
+	return f.TimeRangeVar(new(TimeRange), name, def, usage)
+}
+
+// LocationVar defines a *time.Location flag (e.g. "Europe/Zurich") and binds it to the given pointer.
+func (f *FlagSet) LocationVar(ptr **time.Location, name string, def *time.Location, usage string) *scalar.ScalarFlag[*time.Location] {
+	return f.impl.LocationVar(ptr, name, def, usage)
+}
+
+// Location defines a *time.Location flag and returns its handle.
+func (f *FlagSet) Location(name string, def *time.Location, usage string) *scalar.ScalarFlag[*time.Location] {
+	return f.LocationVar(new(*time.Location), name, def, usage)
+}
+
 // BytesVar defines a uint64 flag with byte parsing and binds it to the given pointer.
 func (f *FlagSet) BytesVar(ptr *uint64, name string, def uint64, usage string) *scalar.ScalarFlag[uint64] {
 	return f.impl.BytesVar(ptr, name, def, usage)
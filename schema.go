@@ -0,0 +1,86 @@
+package tinyflags
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/containeroo/tinyflags/internal/schema"
+)
+
+// Schema types describing the flag and command model.
+type (
+	Schema               = schema.Schema         // Versioned description of a command tree or flag set
+	SchemaCommand        = schema.Command        // One command or flag set
+	SchemaFlag           = schema.Flag           // One static flag or dynamic group field
+	SchemaGroup          = schema.Group          // One dynamic group
+	SchemaOneOfGroup     = schema.OneOfGroup     // One mutual-exclusion group
+	SchemaAllOrNoneGroup = schema.AllOrNoneGroup // One require-together group
+)
+
+// SchemaVersion is the format version reported by Schema.
+const SchemaVersion = schema.Version
+
+// Schema describes every flag, dynamic group and group constraint of the flag set, including hidden ones.
+func (f *FlagSet) Schema() Schema {
+	return Schema{Version: SchemaVersion, Command: f.impl.SchemaCommand()}
+}
+
+// WriteConfigSchema writes a JSON Schema document that validates config files for the flag set.
+func (f *FlagSet) WriteConfigSchema(w io.Writer) error {
+	return writeConfigSchema(w, f.Schema())
+}
+
+// Schema describes c and all of its child commands, including hidden ones.
+// Persistent flags of child commands are listed under Globals; the root's flags are
+// inherited by every child because its local and persistent flag sets are the same.
+func (c *Command) Schema() Schema {
+	return Schema{Version: SchemaVersion, Command: c.schemaCommand()}
+}
+
+// WriteConfigSchema writes a JSON Schema document that validates config files for c,
+// with child command sections nested under their names.
+func (c *Command) WriteConfigSchema(w io.Writer) error {
+	return writeConfigSchema(w, c.Schema())
+}
+
+// schemaCommand converts the command subtree into the schema model.
+func (c *Command) schemaCommand() SchemaCommand {
+	cmd := c.impl.SchemaCommand()
+	cmd.Name = c.name
+	cmd.Path = c.FullName()
+	cmd.Aliases = c.aliases
+	cmd.Summary = c.summary
+	cmd.Deprecated = c.deprecated
+	cmd.Hidden = c.hidden
+
+	if c.globals != c.FlagSet {
+		local := make(map[string]bool, len(cmd.Flags))
+		for _, fl := range cmd.Flags {
+			local[fl.Name] = true
+		}
+		globals := c.globals.impl.SchemaCommand()
+		for _, fl := range globals.Flags {
+			if !local[fl.Name] {
+				cmd.Globals = append(cmd.Globals, fl)
+			}
+		}
+		cmd.Groups = append(globals.Groups, cmd.Groups...)
+		cmd.OneOf = append(globals.OneOf, cmd.OneOf...)
+		cmd.AllOrNone = append(globals.AllOrNone, cmd.AllOrNone...)
+	}
+
+	for _, child := range c.order {
+		cmd.Commands = append(cmd.Commands, child.schemaCommand())
+	}
+	return cmd
+}
+
+// writeConfigSchema encodes the config JSON Schema of s as indented JSON.
+func writeConfigSchema(w io.Writer, s Schema) error {
+	if w == nil {
+		return nil
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s.ConfigSchema())
+}
@@ -2,8 +2,11 @@ package tinyflags
 
 import (
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
+	"regexp"
+	"text/template"
 	"time"
 
 	"github.com/containeroo/tinyflags/internal/slice"
@@ -49,6 +52,56 @@ func (f *FlagSet) Int64Slice(name string, def []int64, usage string) *slice.Slic
 	return f.Int64SliceVar(new([]int64), name, def, usage)
 }
 
+// UintSliceVar defines a []uint flag and binds it to the given pointer.
+func (f *FlagSet) UintSliceVar(ptr *[]uint, name string, def []uint, usage string) *slice.SliceFlag[uint] {
+	return f.impl.UintSliceVar(ptr, name, def, usage)
+}
+
+// UintSlice defines a []uint flag and returns its handle.
+func (f *FlagSet) UintSlice(name string, def []uint, usage string) *slice.SliceFlag[uint] {
+	return f.UintSliceVar(new([]uint), name, def, usage)
+}
+
+// Uint8SliceVar defines a []uint8 flag and binds it to the given pointer.
+func (f *FlagSet) Uint8SliceVar(ptr *[]uint8, name string, def []uint8, usage string) *slice.SliceFlag[uint8] {
+	return f.impl.Uint8SliceVar(ptr, name, def, usage)
+}
+
+// Uint8Slice defines a []uint8 flag and returns its handle.
+func (f *FlagSet) Uint8Slice(name string, def []uint8, usage string) *slice.SliceFlag[uint8] {
+	return f.Uint8SliceVar(new([]uint8), name, def, usage)
+}
+
+// Uint16SliceVar defines a []uint16 flag and binds it to the given pointer.
+func (f *FlagSet) Uint16SliceVar(ptr *[]uint16, name string, def []uint16, usage string) *slice.SliceFlag[uint16] {
+	return f.impl.Uint16SliceVar(ptr, name, def, usage)
+}
+
+// Uint16Slice defines a []uint16 flag and returns its handle.
+func (f *FlagSet) Uint16Slice(name string, def []uint16, usage string) *slice.SliceFlag[uint16] {
+	return f.Uint16SliceVar(new([]uint16), name, def, usage)
+}
+
+// Uint32SliceVar defines a []uint32 flag and binds it to the given pointer.
+func (f *FlagSet) Uint32SliceVar(ptr *[]uint32, name string, def []uint32, usage string) *slice.SliceFlag[uint32] {
+	return f.impl.Uint32SliceVar(ptr, name, def, usage)
+}
+
+// Uint32Slice defines a []uint32 flag and returns its handle.
+func (f *FlagSet) Uint32Slice(name string, def []uint32, usage string) *slice.SliceFlag[uint32] {
+	return f.Uint32SliceVar(new([]uint32), name, def, usage)
+}
+
+// Uint64SliceVar defines a []uint64 flag and binds it to the given pointer.
+func (f *FlagSet) Uint64SliceVar(ptr *[]uint64, name string, def []uint64, usage string) *slice.SliceFlag[uint64] {
+	return f.impl.Uint64SliceVar(ptr, name, def, usage)
+}
+
+// Uint64Slice defines a []uint64 flag and returns its handle.
+func (f *FlagSet) Uint64Slice(name string, def []uint64, usage string) *slice.SliceFlag[uint64] {
+	return f.Uint64SliceVar(new([]uint64), name, def, usage)
+}
+
 // DurationSliceVar defines a []time.Duration flag and binds it to the given pointer.
 func (f *FlagSet) DurationSliceVar(ptr *[]time.Duration, name string, def []time.Duration, usage string) *slice.SliceFlag[time.Duration] {
 	return f.impl.DurationSliceVar(ptr, name, def, usage)
@@ -109,6 +162,76 @@ func (f *FlagSet) TCPAddrSlice(name string, def []*net.TCPAddr, usage string) *s
 	return f.TCPAddrSliceVar(new([]*net.TCPAddr), name, def, usage)
 }
 
+// AddrSliceVar defines a []netip.Addr flag and binds it to the given pointer.
+func (f *FlagSet) AddrSliceVar(ptr *[]netip.Addr, name string, def []netip.Addr, usage string) *slice.SliceFlag[netip.Addr] {
+	return f.impl.AddrSliceVar(ptr, name, def, usage)
+}
+
+// AddrSlice defines a []netip.Addr flag and returns its handle.
+func (f *FlagSet) AddrSlice(name string, def []netip.Addr, usage string) *slice.SliceFlag[netip.Addr] {
+	return f.AddrSliceVar(new([]netip.Addr), name, def, usage)
+}
+
+// AddrPortSliceVar defines a []netip.AddrPort flag and binds it to the given pointer.
+func (f *FlagSet) AddrPortSliceVar(ptr *[]netip.AddrPort, name string, def []netip.AddrPort, usage string) *slice.SliceFlag[netip.AddrPort] {
+	return f.impl.AddrPortSliceVar(ptr, name, def, usage)
+}
+
+// AddrPortSlice defines a []netip.AddrPort flag and returns its handle.
+func (f *FlagSet) AddrPortSlice(name string, def []netip.AddrPort, usage string) *slice.SliceFlag[netip.AddrPort] {
+	return f.AddrPortSliceVar(new([]netip.AddrPort), name, def, usage)
+}
+
+// PrefixSliceVar defines a []netip.Prefix flag and binds it to the given pointer.
+func (f *FlagSet) PrefixSliceVar(ptr *[]netip.Prefix, name string, def []netip.Prefix, usage string) *slice.SliceFlag[netip.Prefix] {
+	return f.impl.PrefixSliceVar(ptr, name, def, usage)
+}
+
+// PrefixSlice defines a []netip.Prefix flag and returns its handle.
+func (f *FlagSet) PrefixSlice(name string, def []netip.Prefix, usage string) *slice.SliceFlag[netip.Prefix] {
+	return f.PrefixSliceVar(new([]netip.Prefix), name, def, usage)
+}
+
+// IPNetSliceVar defines a []*net.IPNet flag and binds it to the given pointer.
+func (f *FlagSet) IPNetSliceVar(ptr *[]*net.IPNet, name string, def []*net.IPNet, usage string) *slice.SliceFlag[*net.IPNet] {
+	return f.impl.IPNetSliceVar(ptr, name, def, usage)
+}
+
+// IPNetSlice defines a []*net.IPNet flag and returns its handle.
+func (f *FlagSet) IPNetSlice(name string, def []*net.IPNet, usage string) *slice.SliceFlag[*net.IPNet] {
+	return f.IPNetSliceVar(new([]*net.IPNet), name, def, usage)
+}
+
+// RegexpSliceVar defines a []*regexp.Regexp flag and binds it to the given pointer.
+func (f *FlagSet) RegexpSliceVar(ptr *[]*regexp.Regexp, name string, def []*regexp.Regexp, usage string) *slice.SliceFlag[*regexp.Regexp] {
+	return f.impl.RegexpSliceVar(ptr, name, def, usage)
+}
+
+// RegexpSlice defines a []*regexp.Regexp flag and returns its handle.
+func (f *FlagSet) RegexpSlice(name string, def []*regexp.Regexp, usage string) *slice.SliceFlag[*regexp.Regexp] {
+	return f.RegexpSliceVar(new([]*regexp.Regexp), name, def, usage)
+}
+
+// GlobSliceVar defines a []string flag and binds it to the given pointer.
+func (f *FlagSet) GlobSliceVar(ptr *[]string, name string, def []string, usage string) *slice.SliceFlag[string] {
+	return f.impl.GlobSliceVar(ptr, name, def, usage)
+}
+
+// GlobSlice defines a []string flag and returns its handle.
+func (f *FlagSet) GlobSlice(name string, def []string, usage string) *slice.SliceFlag[string] {
+	return f.GlobSliceVar(new([]string), name, def, usage)
+}
+
+// TemplateSliceVar defines a []*template.Template flag and binds it to the given pointer.
+func (f *FlagSet) TemplateSliceVar(ptr *[]*template.Template, name string, def []*template.Template, usage string) *slice.SliceFlag[*template.Template] {
+	return f.impl.TemplateSliceVar(ptr, name, def, usage)
+}
+
+// TemplateSlice defines a []*template.Template flag and returns its handle.
+func (f *FlagSet) TemplateSlice(name string, def []*template.Template, usage string) *slice.SliceFlag[*template.Template] {
+	return f.TemplateSliceVar(new([]*template.Template), name, def, usage)
+}
+
 // URLSliceVar defines a []*url.URL flag and binds it to the given pointer.
 func (f *FlagSet) URLSliceVar(ptr *[]*url.URL, name string, def []*url.URL, usage string) *slice.SliceFlag[*url.URL] {
 	return f.impl.URLSliceVar(ptr, name, def, usage)
@@ -139,6 +262,26 @@ func (f *FlagSet) TimeSlice(name string, def []time.Time, usage string) *slice.S
 	return f.TimeSliceVar(new([]time.Time), name, def, usage)
 }
 
+// TimeRangeSliceVar defines a []TimeRange flag and binds it to the given pointer.
+func (f *FlagSet) TimeRangeSliceVar(ptr *[]TimeRange, name string, def []TimeRange, usage string) *slice.SliceFlag[TimeRange] {
+	return f.impl.TimeRangeSliceVar(ptr, name, def, usage)
+}
+
+// TimeRangeSlice defines a []TimeRange flag and returns its handle.
+func (f *FlagSet) TimeRangeSlice(name string, def []TimeRange, usage string) *slice.SliceFlag[TimeRange] {
+	return f.TimeRangeSliceVar(new([]TimeRange), name, def, usage)
+}
+
+// LocationSliceVar defines a []*time.Location flag and binds it to the given pointer.
+func (f *FlagSet) LocationSliceVar(ptr *[]*time.Location, name string, def []*time.Location, usage string) *slice.SliceFlag[*time.Location] {
+	return f.impl.LocationSliceVar(ptr, name, def, usage)
+}
+
+// LocationSlice defines a []*time.Location flag and returns its handle.
+func (f *FlagSet) LocationSlice(name string, def []*time.Location, usage string) *slice.SliceFlag[*time.Location] {
+	return f.LocationSliceVar(new([]*time.Location), name, def, usage)
+}
+
 // BytesSliceVar defines a []uint64 flag that parses human-readable sizes and binds it to the given pointer.
 func (f *FlagSet) BytesSliceVar(ptr *[]uint64, name string, def []uint64, usage string) *slice.SliceFlag[uint64] {
 	return f.impl.BytesSliceVar(ptr, name, def, usage)
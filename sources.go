@@ -0,0 +1,39 @@
+package tinyflags
+
+import "github.com/containeroo/tinyflags/internal/core"
+
+// Value provenance types.
+type (
+	Source     = core.Source     // Where a flag value came from, with the masked value
+	SourceKind = core.SourceKind // Origin category of a flag value
+)
+
+const (
+	SourceDefault   = core.SourceDefault   // Registered default, untouched
+	SourceCLI       = core.SourceCLI       // Command-line argument
+	SourceEnv       = core.SourceEnv       // Environment variable
+	SourceConfig    = core.SourceConfig    // Config file entry
+	SourceFinalizer = core.SourceFinalizer // Default rewritten by a default-value finalizer
+)
+
+// Sources returns the source of every flag value from the last parse, keyed like OverriddenValues.
+// Values pass through OverriddenValueMaskFn; dynamic keys use "group.id.flag".
+func (f *FlagSet) Sources() map[string]Source { return f.impl.Sources() }
+
+// Sources returns the sources for all flags visible to the selected command,
+// preferring local flags over inherited persistent ones.
+func (c *Command) Sources() map[string]Source {
+	target := c
+	if c.selected != nil {
+		target = c.selected
+	}
+	out := make(map[string]Source)
+	for _, fs := range target.availableFlagSets() {
+		for key, src := range fs.impl.Sources() {
+			if _, exists := out[key]; !exists {
+				out[key] = src
+			}
+		}
+	}
+	return out
+}
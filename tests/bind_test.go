@@ -0,0 +1,182 @@
+package tinyflags_test
+
+import (
+	"net"
+	"os"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBind verifies struct tag based flag registration.
+func TestBind(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		Port    int           `flag:"port" short:"p" env:"BIND_TEST_PORT" usage:"Listen port" default:"8080"`
+		Timeout time.Duration `usage:"Request timeout" default:"5s"`
+	}
+	type backend struct {
+		Addr   string `usage:"Backend address" required:"true"`
+		Weight int    `usage:"Backend weight" default:"1"`
+	}
+	type config struct {
+		Server   server
+		Name     string             `usage:"Instance name" required:"true"`
+		LogLevel string             `usage:"Log level" choices:"debug,info" default:"info"`
+		Tags     []string           `usage:"Tags" default:"a,b"`
+		Verbose  bool               `short:"v" usage:"Verbose output"`
+		Labels   map[string]string  `usage:"Labels" default:"env=dev"`
+		Secret   string             `flag:"-"`
+		Backends map[string]backend `flag:"backend"`
+	}
+
+	t.Run("defaults and parsed values", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg config
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		require.NoError(t, tinyflags.Bind(fs, &cfg))
+
+		require.NoError(t, fs.Parse([]string{"--name=web", "-v", "--server-timeout=1s"}))
+		assert.Equal(t, "web", cfg.Name)
+		assert.Equal(t, 8080, cfg.Server.Port)
+		assert.Equal(t, time.Second, cfg.Server.Timeout)
+		assert.Equal(t, "info", cfg.LogLevel)
+		assert.Equal(t, []string{"a", "b"}, cfg.Tags)
+		assert.True(t, cfg.Verbose)
+		assert.Equal(t, map[string]string{"env": "dev"}, cfg.Labels)
+		assert.Empty(t, cfg.Backends)
+
+		require.NoError(t, fs.Parse([]string{"--name=web", "-p", "9090", "--tags=x,y", "--log-level=debug"}))
+		assert.Equal(t, 9090, cfg.Server.Port)
+		assert.Equal(t, []string{"x", "y"}, cfg.Tags)
+		assert.Equal(t, "debug", cfg.LogLevel)
+
+		require.NoError(t, fs.Parse([]string{"--name=web", "--labels=env=prod,team=core"}))
+		assert.Equal(t, map[string]string{"env": "prod", "team": "core"}, cfg.Labels)
+	})
+
+	t.Run("field value is the default", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := struct {
+			Retries int
+		}{Retries: 3}
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		require.NoError(t, tinyflags.Bind(fs, &cfg))
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, 3, cfg.Retries)
+	})
+
+	t.Run("required and choices", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg config
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		require.NoError(t, tinyflags.Bind(fs, &cfg))
+		assert.EqualError(t, fs.Parse(nil), "flag --name is required")
+
+		err := fs.Parse([]string{"--name=web", "--log-level=trace"})
+		assert.EqualError(t, err, "invalid value for flag --log-level: must be one of: debug, info")
+	})
+
+	t.Run("map of structs becomes a dynamic group", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg config
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		require.NoError(t, tinyflags.Bind(fs, &cfg))
+
+		require.NoError(t, fs.Parse([]string{
+			"--name=web",
+			"--backend.a.addr=10.0.0.1",
+			"--backend.b.addr=10.0.0.2",
+			"--backend.b.weight=5",
+		}))
+		assert.Equal(t, map[string]backend{
+			"a": {Addr: "10.0.0.1", Weight: 1},
+			"b": {Addr: "10.0.0.2", Weight: 5},
+		}, cfg.Backends)
+	})
+
+	t.Run("invalid targets", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		assert.EqualError(t, tinyflags.Bind(fs, config{}), "tinyflags: Bind requires a non-nil pointer to a struct, got tinyflags_test.config")
+
+		var bad struct {
+			Ch chan int
+		}
+		assert.EqualError(t, tinyflags.Bind(fs, &bad), "tinyflags: field Ch: unsupported type chan int")
+
+		var badDefault struct {
+			Port int `default:"x"`
+		}
+		err := tinyflags.Bind(tinyflags.NewFlagSet("app", tinyflags.ContinueOnError), &badDefault)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid default "x"`)
+
+		var badKind struct {
+			Size int `type:"bytes"`
+		}
+		err = tinyflags.Bind(tinyflags.NewFlagSet("app", tinyflags.ContinueOnError), &badKind)
+		assert.EqualError(t, err, `tinyflags: field Size: type tag "bytes" does not apply to int`)
+
+		var badShort struct {
+			Backends map[string]backend `flag:"backend"`
+			Workers  map[string]struct {
+				Port int `short:"p"`
+			}
+		}
+		err = tinyflags.Bind(tinyflags.NewFlagSet("app", tinyflags.ContinueOnError), &badShort)
+		assert.EqualError(t, err, "tinyflags: field Workers: dynamic field Port: short tag is not supported on dynamic fields")
+	})
+
+	t.Run("every built-in type and its slice", func(t *testing.T) {
+		t.Parallel()
+
+		type all struct {
+			Octets    []uint8              `flag:"octets"`
+			Masks     []net.IPMask         `flag:"masks"`
+			Templates []*template.Template `flag:"templates" default:"{{.A}}"`
+			Zones     []*time.Location     `flag:"zones"`
+			Size      uint64               `flag:"size" type:"bytes" default:"1KiB"`
+			Sizes     []uint64             `flag:"sizes" type:"bytes"`
+			Include   string               `flag:"include" type:"glob"`
+			Input     *os.File             `flag:"input"`
+		}
+		var cfg all
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		require.NoError(t, tinyflags.Bind(fs, &cfg))
+		mask := tinyflags.Positional[net.IPMask](fs, "mask", "Mask")
+
+		require.NoError(t, fs.Parse([]string{
+			"--octets=1,2", "--masks=255.255.0.0", "--zones=UTC,Asia/Tokyo",
+			"--sizes=2MiB,1KB", "--include=*.go", "--input=bind_test.go", "255.0.0.0",
+		}))
+		t.Cleanup(func() { cfg.Input.Close() }) // nolint:errcheck
+		assert.Equal(t, []uint8{1, 2}, cfg.Octets)
+		assert.Equal(t, []net.IPMask{net.CIDRMask(16, 32)}, cfg.Masks)
+		require.Len(t, cfg.Templates, 1)
+		assert.Equal(t, "templates", cfg.Templates[0].Name())
+		assert.Equal(t, "Asia/Tokyo", cfg.Zones[1].String())
+		assert.Equal(t, uint64(1024), cfg.Size)
+		assert.Equal(t, []uint64{2 << 20, 1000}, cfg.Sizes)
+		assert.Equal(t, "*.go", cfg.Include)
+		assert.Equal(t, "bind_test.go", cfg.Input.Name())
+		assert.Equal(t, net.CIDRMask(8, 32), *mask.Value())
+		flags := fs.Schema().Command.Flags
+		assert.Equal(t, "bytes", flagByName(t, flags, "size").Format)
+		assert.Equal(t, "bytes", flagByName(t, flags, "sizes").Format)
+
+		err := fs.Parse([]string{"--include=[a-", "255.0.0.0"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid glob pattern "[a-"`)
+	})
+}
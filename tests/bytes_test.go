@@ -0,0 +1,144 @@
+package tinyflags_test
+
+import (
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBytesFlag verifies human-friendly byte size parsing and rendering.
+func TestBytesFlag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parse", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			arg  string
+			want uint64
+		}{
+			{arg: "512", want: 512},
+			{arg: "512B", want: 512},
+			{arg: "10KB", want: 10_000},
+			{arg: "10kb", want: 10_000},
+			{arg: "10K", want: 10_000},
+			{arg: "10KiB", want: 10_240},
+			{arg: "10kib", want: 10_240},
+			{arg: "10MiB", want: 10_485_760},
+			{arg: "1.5G", want: 1_500_000_000},
+			{arg: "1.5GiB", want: 1_610_612_736},
+			{arg: "2 TB", want: 2_000_000_000_000},
+			{arg: "1_000MB", want: 1_000_000_000},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.arg, func(t *testing.T) {
+				t.Parallel()
+
+				fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+				v := fs.Bytes("size", 0, "Size").Value()
+				require.NoError(t, fs.Parse([]string{"--size", tt.arg}))
+				assert.Equal(t, tt.want, *v)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			arg  string
+			want string
+		}{
+			{arg: "16EiB", want: `invalid byte size "16EiB": value out of range`},
+			{arg: "18446744073709551616", want: `invalid byte size "18446744073709551616": value out of range`},
+			{arg: "20.5EB", want: `invalid byte size "20.5EB": value out of range`},
+			{arg: "1.5", want: `invalid byte size "1.5": not a whole number of bytes`},
+			{arg: "10XB", want: `invalid byte size "10XB": unknown unit "XB"`},
+			{arg: "MB", want: `invalid byte size "MB": missing number`},
+			{arg: "-1KB", want: `invalid byte size "-1KB": missing number`},
+			{arg: "1.2.3KB", want: `invalid byte size "1.2.3KB": invalid number`},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.arg, func(t *testing.T) {
+				t.Parallel()
+
+				fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+				fs.Bytes("size", 0, "Size")
+				err := fs.Parse([]string{"--size=" + tt.arg})
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.want)
+			})
+		}
+	})
+
+	t.Run("help renders readable defaults", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name  string
+			units tinyflags.ByteUnits
+			def   uint64
+			want  string
+		}{
+			{name: "auto iec", units: tinyflags.ByteUnitsAuto, def: 10 << 20, want: "(default: 10MiB)"},
+			{name: "auto si", units: tinyflags.ByteUnitsAuto, def: 1_500_000_000, want: "(default: 1.5GB)"},
+			{name: "auto small", units: tinyflags.ByteUnitsAuto, def: 100, want: "(default: 100B)"},
+			{name: "forced si", units: tinyflags.ByteUnitsSI, def: 10 << 20, want: "(default: 10485.76KB)"},
+			{name: "forced iec", units: tinyflags.ByteUnitsIEC, def: 3 << 19, want: "(default: 1.5MiB)"},
+			{name: "forced iec inexact", units: tinyflags.ByteUnitsIEC, def: 1_000_000, want: "(default: 1000000B)"},
+			{name: "plain", units: tinyflags.ByteUnitsPlain, def: 10 << 20, want: "(default: 10485760)"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+				fs.SetByteUnits(tt.units)
+				fs.Bytes("size", tt.def, "Size")
+				err := fs.Parse([]string{"--help"})
+				require.True(t, tinyflags.IsHelpRequested(err))
+				assert.Contains(t, err.Error(), tt.want)
+			})
+		}
+	})
+
+	t.Run("child commands inherit units", func(t *testing.T) {
+		t.Parallel()
+
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+		serve := root.Command("serve", "Serve")
+		serve.Bytes("size", 10<<20, "Size")
+		serve.DynamicGroup("cache").Bytes("limit", 1<<30, "Limit")
+		root.SetByteUnits(tinyflags.ByteUnitsPlain)
+
+		err := root.Parse([]string{"serve", "--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "(default: 10485760)")
+		assert.Contains(t, err.Error(), "(default: 1073741824)")
+
+		serve.SetByteUnits(tinyflags.ByteUnitsIEC)
+		err = root.Parse([]string{"serve", "--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "(default: 10MiB)")
+	})
+
+	t.Run("slices and dynamic groups", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		limits := fs.BytesSlice("limit", nil, "Limits").Value()
+		cache := fs.DynamicGroup("cache").Bytes("size", 1<<30, "Cache size")
+
+		require.NoError(t, fs.Parse([]string{"--limit=1KiB,2MB", "--cache.a.size=256MiB"}))
+		assert.Equal(t, []uint64{1024, 2_000_000}, *limits)
+		size, _ := cache.Get("a")
+		assert.Equal(t, uint64(256<<20), size)
+		size, _ = cache.Get("b")
+		assert.Equal(t, uint64(1<<30), size)
+	})
+}
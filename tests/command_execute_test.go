@@ -0,0 +1,91 @@
+package tinyflags_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCommandExecute verifies output streams and exit codes of Execute.
+func TestCommandExecute(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(runErr error) (*tinyflags.Command, *bytes.Buffer, *bytes.Buffer) {
+		var stdout, stderr bytes.Buffer
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError).
+			SetStdout(&stdout).
+			SetStderr(&stderr).
+			NotifySignals()
+		root.Version("v1.2.3")
+		root.Command("serve", "Run the server").Run(func() error { return runErr })
+		admin := root.Command("admin", "Admin tasks").RequireCommand()
+		admin.Command("users", "Manage users").Run(func() {})
+		return root, &stdout, &stderr
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		root, stdout, stderr := newApp(nil)
+		assert.Equal(t, tinyflags.ExitOK, root.Execute(context.Background(), []string{"serve"}))
+		assert.Empty(t, stdout.String())
+		assert.Empty(t, stderr.String())
+	})
+
+	t.Run("help and version go to stdout", func(t *testing.T) {
+		t.Parallel()
+
+		root, stdout, stderr := newApp(nil)
+		assert.Equal(t, tinyflags.ExitOK, root.Execute(context.Background(), []string{"serve", "--help"}))
+		assert.Contains(t, stdout.String(), "Usage: app serve")
+		assert.Empty(t, stderr.String())
+
+		root, stdout, _ = newApp(nil)
+		assert.Equal(t, tinyflags.ExitOK, root.Execute(context.Background(), []string{"--version"}))
+		assert.Equal(t, "v1.2.3\n", stdout.String())
+	})
+
+	t.Run("parse errors exit with usage code", func(t *testing.T) {
+		t.Parallel()
+
+		root, stdout, stderr := newApp(nil)
+		assert.Equal(t, tinyflags.ExitUsage, root.Execute(context.Background(), []string{"serve", "--nope"}))
+		assert.Empty(t, stdout.String())
+		assert.Equal(t, "Error: unknown flag --nope\n", stderr.String())
+
+		root, _, stderr = newApp(nil)
+		assert.Equal(t, tinyflags.ExitUsage, root.Execute(context.Background(), []string{"admin"}))
+		assert.Contains(t, stderr.String(), "Error: command \"app admin\" requires a subcommand\n\nUsage: app admin")
+	})
+
+	t.Run("handler errors", func(t *testing.T) {
+		t.Parallel()
+
+		root, _, stderr := newApp(errors.New("boom"))
+		assert.Equal(t, tinyflags.ExitFail, root.Execute(context.Background(), []string{"serve"}))
+		assert.Equal(t, "Error: boom\n", stderr.String())
+
+		root, _, stderr = newApp(fmt.Errorf("wrapped: %w", tinyflags.WithExitCode(errors.New("not found"), 4)))
+		assert.Equal(t, 4, root.Execute(context.Background(), []string{"serve"}))
+		assert.Equal(t, "Error: wrapped: not found\n", stderr.String())
+
+		root, _, stderr = newApp(tinyflags.WithExitCode(errors.New(""), 3))
+		assert.Equal(t, 3, root.Execute(context.Background(), []string{"serve"}))
+		assert.Empty(t, stderr.String())
+	})
+
+	t.Run("execute and exit", func(t *testing.T) {
+		t.Parallel()
+
+		code := -1
+		root, _, _ := newApp(nil)
+		root.SetExitFunc(func(c int) { code = c })
+		root.ExecuteAndExit(context.Background())
+		assert.NotEqual(t, -1, code)
+	})
+}
@@ -0,0 +1,56 @@
+//go:build unix
+
+package tinyflags_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCommandExecuteSignals verifies cancellation on the first signal and the forced exit on the second.
+func TestCommandExecuteSignals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("first signal cancels the context", func(t *testing.T) {
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError).
+			SetStderr(io.Discard).
+			NotifySignals(syscall.SIGUSR1)
+		root.Run(func(ctx context.Context) error {
+			require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGUSR1))
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		assert.Equal(t, 128+int(syscall.SIGUSR1), root.Execute(context.Background(), nil))
+	})
+
+	t.Run("second signal forces exit", func(t *testing.T) {
+		exited := make(chan int, 1)
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError).
+			SetStderr(io.Discard).
+			NotifySignals(syscall.SIGUSR2).
+			SetExitFunc(func(code int) { exited <- code })
+		root.Run(func(ctx context.Context) error {
+			require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGUSR2))
+			<-ctx.Done()
+			require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGUSR2))
+			select {
+			case code := <-exited:
+				exited <- code
+			case <-time.After(5 * time.Second):
+			}
+			return nil
+		})
+
+		assert.Equal(t, tinyflags.ExitOK, root.Execute(context.Background(), nil))
+		assert.Equal(t, 128+int(syscall.SIGUSR2), <-exited)
+	})
+}
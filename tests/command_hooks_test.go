@@ -0,0 +1,125 @@
+package tinyflags_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCommandHooks verifies pre/post hooks and middleware around the selected handler.
+func TestCommandHooks(t *testing.T) {
+	t.Parallel()
+
+	type key struct{}
+
+	newApp := func(calls *[]string) (*tinyflags.Command, *tinyflags.Command) {
+		record := func(name string) tinyflags.CommandHook {
+			return func(_ context.Context, cmd *tinyflags.Command) error {
+				*calls = append(*calls, name+":"+cmd.Name())
+				return nil
+			}
+		}
+		middleware := func(name string) tinyflags.Middleware {
+			return func(next tinyflags.Runner) tinyflags.Runner {
+				return tinyflags.RunnerFunc(func(ctx context.Context) error {
+					*calls = append(*calls, name+":before")
+					err := next.Run(ctx)
+					*calls = append(*calls, name+":after")
+					return err
+				})
+			}
+		}
+
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+		root.PersistentPreRun(record("root-persistent-pre")).
+			PersistentPostRun(record("root-persistent-post")).
+			PreRun(record("root-pre")).
+			Use(middleware("root-mw"))
+		root.Run(func() { *calls = append(*calls, "root") })
+
+		serve := root.Command("serve", "Run the server")
+		serve.PersistentPreRun(record("serve-persistent-pre")).
+			PersistentPostRun(record("serve-persistent-post")).
+			PreRun(record("serve-pre")).
+			PostRun(record("serve-post")).
+			Use(middleware("serve-mw"))
+		serve.Run(func(ctx context.Context) error {
+			*calls = append(*calls, "serve")
+			if ctx.Value(key{}) != nil {
+				return errors.New("handler failed")
+			}
+			return nil
+		})
+		return root, serve
+	}
+
+	t.Run("order along the command path", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []string
+		root, _ := newApp(&calls)
+		runner, err := root.ParseRunner([]string{"serve"})
+		require.NoError(t, err)
+		require.NoError(t, runner.Run(context.Background()))
+		assert.Equal(t, []string{
+			"root-mw:before",
+			"serve-mw:before",
+			"root-persistent-pre:serve",
+			"serve-persistent-pre:serve",
+			"serve-pre:serve",
+			"serve",
+			"serve-post:serve",
+			"serve-persistent-post:serve",
+			"root-persistent-post:serve",
+			"serve-mw:after",
+			"root-mw:after",
+		}, calls)
+	})
+
+	t.Run("local hooks only run for the selected command", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []string
+		root, _ := newApp(&calls)
+		runner, err := root.ParseRunner(nil)
+		require.NoError(t, err)
+		require.NoError(t, runner.Run(context.Background()))
+		assert.Equal(t, []string{
+			"root-mw:before",
+			"root-persistent-pre:app",
+			"root-pre:app",
+			"root",
+			"root-persistent-post:app",
+			"root-mw:after",
+		}, calls)
+	})
+
+	t.Run("failing pre hook stops the run", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []string
+		root, serve := newApp(&calls)
+		serve.PreRun(func(context.Context, *tinyflags.Command) error { return errors.New("not ready") })
+		runner, err := root.ParseRunner([]string{"serve"})
+		require.NoError(t, err)
+		assert.EqualError(t, runner.Run(context.Background()), "not ready")
+		assert.NotContains(t, calls, "serve")
+		assert.NotContains(t, calls, "serve-post:serve")
+	})
+
+	t.Run("post hooks run after a failing handler", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []string
+		root, _ := newApp(&calls)
+		runner, err := root.ParseRunner([]string{"serve"})
+		require.NoError(t, err)
+		ctx := context.WithValue(context.Background(), key{}, true)
+		assert.EqualError(t, runner.Run(ctx), "handler failed")
+		assert.Contains(t, calls, "root-persistent-post:serve")
+	})
+}
@@ -0,0 +1,135 @@
+//go:build unix
+
+package tinyflags_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCommandPlugins verifies discovery and execution of external "<root>-<name>" plugins.
+func TestCommandPlugins(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeScript := func(name, body string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+body+"\n"), 0o755))
+	}
+	writeScript("app-hello", `echo "hello $* $HOME"`)
+	writeScript("app-fail", `echo "broken" >&2; exit 3`)
+	writeScript("app-serve", `echo "shadowed"`)
+	writeScript("other-tool", `exit 0`)
+	writeScript("app-killed", `kill -TERM $$`)
+	writeScript("app-wait", `trap 'echo cleanup; exit 0' TERM; echo ready; while :; do sleep 0.05; done`)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app-notes"), []byte("text"), 0o644))
+
+	newApp := func() (*tinyflags.Command, *bytes.Buffer, *bytes.Buffer, *bool) {
+		var stdout, stderr bytes.Buffer
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError).
+			SetStdout(&stdout).
+			SetStderr(&stderr).
+			NotifySignals().
+			EnablePlugins(dir)
+		verbose := root.Bool("verbose", false, "Verbose").Value()
+		root.Command("serve", "Run the server").Run(func() {})
+		return root, &stdout, &stderr, verbose
+	}
+
+	t.Run("runs plugin with remaining args and env", func(t *testing.T) {
+		t.Parallel()
+
+		root, stdout, _, verbose := newApp()
+		code := root.Execute(context.Background(), []string{"--verbose", "hello", "--name", "x", "serve"})
+		assert.Equal(t, tinyflags.ExitOK, code)
+		assert.Equal(t, "hello --name x serve "+os.Getenv("HOME")+"\n", stdout.String())
+		assert.True(t, *verbose)
+
+		plugin := root.SelectedPlugin()
+		require.NotNil(t, plugin)
+		assert.Equal(t, "hello", plugin.Name)
+		assert.Equal(t, filepath.Join(dir, "app-hello"), plugin.Path)
+	})
+
+	t.Run("exit code is passed through", func(t *testing.T) {
+		t.Parallel()
+
+		root, _, stderr, _ := newApp()
+		assert.Equal(t, 3, root.Execute(context.Background(), []string{"fail"}))
+		assert.Equal(t, "broken\n", stderr.String())
+	})
+
+	t.Run("signaled plugin exits with 128+signal", func(t *testing.T) {
+		t.Parallel()
+
+		root, _, _, _ := newApp()
+		assert.Equal(t, 128+int(syscall.SIGTERM), root.Execute(context.Background(), []string{"killed"}))
+	})
+
+	t.Run("cancel lets the plugin clean up", func(t *testing.T) {
+		t.Parallel()
+
+		root, stdout, _, _ := newApp()
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+		defer cancel()
+		root.Execute(ctx, []string{"wait"})
+		assert.Equal(t, "ready\ncleanup\n", stdout.String())
+	})
+
+	t.Run("no lookup without child commands", func(t *testing.T) {
+		t.Parallel()
+
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError).EnablePlugins(dir)
+		require.NoError(t, root.Parse([]string{"hello"}))
+		assert.Nil(t, root.SelectedPlugin())
+		assert.Equal(t, []string{"hello"}, root.Args())
+
+		root.RequireCommand()
+		require.NoError(t, root.Parse([]string{"hello"}))
+		assert.NotNil(t, root.SelectedPlugin())
+	})
+
+	t.Run("built-in commands win", func(t *testing.T) {
+		t.Parallel()
+
+		root, stdout, _, _ := newApp()
+		assert.Equal(t, tinyflags.ExitOK, root.Execute(context.Background(), []string{"serve"}))
+		assert.Empty(t, stdout.String())
+		assert.Nil(t, root.SelectedPlugin())
+	})
+
+	t.Run("listed in help", func(t *testing.T) {
+		t.Parallel()
+
+		root, _, _, _ := newApp()
+		assert.Equal(t, []tinyflags.Plugin{
+			{Name: "fail", Path: filepath.Join(dir, "app-fail")},
+			{Name: "hello", Path: filepath.Join(dir, "app-hello")},
+			{Name: "killed", Path: filepath.Join(dir, "app-killed")},
+			{Name: "wait", Path: filepath.Join(dir, "app-wait")},
+		}, root.Plugins())
+
+		err := root.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "\nPlugins:\n  fail\n  hello\n  killed\n  wait\n")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		root, _, _, _ := newApp()
+		root.DisablePlugins()
+		require.NoError(t, root.Parse([]string{"hello"}))
+		assert.Nil(t, root.SelectedPlugin())
+		assert.Equal(t, []string{"hello"}, root.Args())
+		assert.Empty(t, root.Plugins())
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/containeroo/tinyflags"
@@ -242,3 +243,169 @@ func TestParseRunnerRequiresHandler(t *testing.T) {
 	assert.Nil(t, runner)
 	assert.Contains(t, err.Error(), `no command runner registered for command "app serve"`)
 }
+
+// TestCommandAliases verifies aliases select the same command node.
+func TestCommandAliases(t *testing.T) {
+	t.Parallel()
+
+	newApp := func() (*tinyflags.Command, *tinyflags.Command) {
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+		remove := root.Command("remove", "Remove items").Aliases("rm", "del")
+		remove.Bool("force", false, "force").Short("f")
+		root.Command("list", "List items")
+		return root, remove
+	}
+
+	t.Run("alias routes to command", func(t *testing.T) {
+		t.Parallel()
+
+		root, remove := newApp()
+		require.NoError(t, root.Parse([]string{"rm", "-f", "item"}))
+		assert.Same(t, remove, root.SelectedCommand())
+		assert.Equal(t, "app remove", root.SelectedCommand().FullName())
+		assert.Equal(t, []string{"item"}, remove.Args())
+		assert.Equal(t, []string{"rm", "del"}, remove.AliasNames())
+	})
+
+	t.Run("help lists aliases", func(t *testing.T) {
+		t.Parallel()
+
+		root, _ := newApp()
+		assert.Contains(t, root.HelpText(), "  remove, rm, del  Remove items\n")
+		assert.Contains(t, root.HelpText(), "  list             List items\n")
+	})
+
+	t.Run("aliases are suggested", func(t *testing.T) {
+		t.Parallel()
+
+		root, _ := newApp()
+		err := root.Parse([]string{"dle"})
+		assert.EqualError(t, err, `unknown command "dle" for "app" (did you mean "del"?)`)
+	})
+
+	t.Run("completion scripts accept aliases", func(t *testing.T) {
+		t.Parallel()
+
+		root, _ := newApp()
+		var buf bytes.Buffer
+		require.NoError(t, root.GenCompletion(&buf, "bash"))
+		assert.Contains(t, buf.String(), `'remove'|'rm'|'del') cmdpath='app remove' ;;`)
+	})
+
+	t.Run("duplicate names panic", func(t *testing.T) {
+		t.Parallel()
+
+		root, remove := newApp()
+		assert.PanicsWithValue(t, `tinyflags: command "app" already has a child named "list"`, func() {
+			remove.Aliases("list")
+		})
+		assert.PanicsWithValue(t, `tinyflags: command "app" already has a child named "rm"`, func() {
+			root.Command("rm", "Remove")
+		})
+		assert.PanicsWithValue(t, `tinyflags: command "app" already has a child named "remove"`, func() {
+			root.Command("remove", "Remove")
+		})
+		assert.PanicsWithValue(t, "tinyflags: aliases require a child command", func() {
+			root.Aliases("main")
+		})
+	})
+}
+
+// TestHiddenCommand verifies hidden commands route but stay out of listings.
+func TestHiddenCommand(t *testing.T) {
+	t.Parallel()
+
+	root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+	root.Command("serve", "Run the server")
+	maint := root.Command("maint", "Maintenance tasks").Hidden()
+
+	assert.True(t, maint.IsHidden())
+	assert.NotContains(t, root.HelpText(), "maint")
+
+	var buf bytes.Buffer
+	require.NoError(t, root.GenMarkdown(&buf))
+	assert.NotContains(t, buf.String(), "maint")
+
+	require.NoError(t, root.Parse([]string{"maint"}))
+	assert.Same(t, maint, root.SelectedCommand())
+}
+
+// TestDeprecatedCommand verifies deprecated commands warn and carry a marker.
+func TestDeprecatedCommand(t *testing.T) {
+	t.Parallel()
+
+	newApp := func() (*tinyflags.Command, *bytes.Buffer) {
+		var out bytes.Buffer
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+		root.SetStderr(&out)
+		root.Command("delete", "Delete items")
+		root.Command("remove", "Remove items").Deprecated(`use "delete"`)
+		return root, &out
+	}
+
+	t.Run("still runs with a warning", func(t *testing.T) {
+		t.Parallel()
+
+		root, out := newApp()
+		ran := false
+		root.Commands()[1].Run(func() error {
+			ran = true
+			return nil
+		})
+
+		runner, err := root.ParseRunner([]string{"remove"})
+		require.NoError(t, err)
+		require.NoError(t, runner.Run(context.Background()))
+		assert.True(t, ran)
+		assert.Equal(t, "Warning: command \"app remove\" is deprecated: use \"delete\"\n", out.String())
+		assert.Equal(t, `use "delete"`, root.SelectedCommand().DeprecationNotice())
+	})
+
+	t.Run("execute keeps stdout clean", func(t *testing.T) {
+		t.Parallel()
+
+		var stdout, stderr bytes.Buffer
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+		root.SetOutput(&stdout)
+		root.SetStdout(&stdout).SetStderr(&stderr)
+		root.Command("remove", "Remove items").Deprecated(`use "delete"`).Run(func() error {
+			fmt.Fprint(&stdout, "data")
+			return nil
+		})
+
+		assert.Equal(t, tinyflags.ExitOK, root.Execute(context.Background(), []string{"remove"}))
+		assert.Equal(t, "data", stdout.String())
+		assert.Equal(t, "Warning: command \"app remove\" is deprecated: use \"delete\"\n", stderr.String())
+	})
+
+	t.Run("no warning for current commands or help", func(t *testing.T) {
+		t.Parallel()
+
+		root, out := newApp()
+		require.NoError(t, root.Parse([]string{"delete"}))
+		err := root.Parse([]string{"remove", "--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Empty(t, out.String())
+	})
+
+	t.Run("help and docs show a marker", func(t *testing.T) {
+		t.Parallel()
+
+		root, _ := newApp()
+		assert.Contains(t, root.HelpText(), `  remove  Remove items (deprecated: use "delete")`)
+
+		var buf bytes.Buffer
+		require.NoError(t, root.GenMarkdown(&buf))
+		assert.Contains(t, buf.String(), `Remove items (deprecated: use "delete")`)
+
+		buf.Reset()
+		require.NoError(t, root.Commands()[1].GenMarkdown(&buf))
+		assert.Contains(t, buf.String(), "**Deprecated:** use \"delete\"\n")
+
+		buf.Reset()
+		require.NoError(t, root.Commands()[1].GenManPage(&buf, tinyflags.ManHeader{}))
+		assert.Contains(t, buf.String(), ".SH DEPRECATED\n")
+
+		assert.Equal(t, `use "delete"`, root.Schema().Command.Commands[1].Deprecated)
+	})
+}
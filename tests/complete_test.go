@@ -0,0 +1,209 @@
+package tinyflags_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRuntimeCompletionApp builds a command tree with runtime completers.
+func newRuntimeCompletionApp() *tinyflags.Command {
+	root := tinyflags.NewCommand("kube", tinyflags.ContinueOnError)
+	root.Globals().String("namespace", "default", "Namespace").Short("n").
+		Complete(func(_ context.Context, prefix string) []tinyflags.Completion {
+			return []tinyflags.Completion{
+				{Value: "default", Description: "Default namespace"},
+				{Value: "dev"},
+				{Value: "kube-system", Description: "System\tcomponents\nsecond line"},
+			}
+		})
+	root.Globals().String("config", "", "Config file").CompleteFiles("yaml", ".yml")
+	root.Globals().String("dir", "", "Work dir").CompleteDirs()
+
+	get := root.Command("get", "Get resources")
+	get.String("output", "text", "Output format").Short("o").Choices("text", "json")
+	get.StringSlice("label", nil, "Labels").Complete(func(_ context.Context, prefix string) []tinyflags.Completion {
+		return []tinyflags.Completion{{Value: "app"}, {Value: "tier"}}
+	})
+	get.CompleteArgs(func(_ context.Context, prefix string) []tinyflags.Completion {
+		return []tinyflags.Completion{{Value: "pods"}, {Value: "services"}}
+	})
+
+	proxy := root.Command("proxy", "Manage proxies")
+	http := proxy.DynamicGroup("http")
+	http.Title("HTTP targets")
+	http.String("addr", "", "Target address").Complete(func(_ context.Context, prefix string) []tinyflags.Completion {
+		return []tinyflags.Completion{{Value: "localhost:80"}}
+	})
+	http.Int("port", 80, "Target port")
+	http.Bool("debug", false, "Debug").Hidden()
+
+	return root
+}
+
+// values extracts candidate values.
+func values(comps []tinyflags.Completion) []string {
+	var out []string
+	for _, c := range comps {
+		out = append(out, c.Value)
+	}
+	return out
+}
+
+// TestCompletions verifies runtime completion routing.
+func TestCompletions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cases := []struct {
+		name      string
+		args      []string
+		want      []string
+		directive tinyflags.CompletionDirective
+	}{
+		{name: "commands", args: []string{""}, want: []string{"get", "proxy"}, directive: tinyflags.CompleteNoFile},
+		{name: "command prefix", args: []string{"g"}, want: []string{"get"}, directive: tinyflags.CompleteNoFile},
+		{name: "flag completer", args: []string{"--namespace", "d"}, want: []string{"default", "dev"}, directive: tinyflags.CompleteNoFile},
+		{name: "short flag completer", args: []string{"get", "-n", "k"}, want: []string{"kube-system"}, directive: tinyflags.CompleteNoFile},
+		{name: "inline value", args: []string{"--namespace=de"}, want: []string{"--namespace=default", "--namespace=dev"}, directive: tinyflags.CompleteNoFile},
+		{name: "choices", args: []string{"get", "-o", ""}, want: []string{"text", "json"}, directive: tinyflags.CompleteNoFile},
+		{name: "slice completer", args: []string{"get", "--label", "t"}, want: []string{"tier"}, directive: tinyflags.CompleteNoFile},
+		{name: "positional completer", args: []string{"get", "--output", "json", "s"}, want: []string{"services"}, directive: tinyflags.CompleteNoFile},
+		{name: "file extensions", args: []string{"--config", ""}, want: []string{"yaml", "yml"}, directive: tinyflags.CompleteFilterExt},
+		{name: "file extensions inline", args: []string{"--config=x"}, want: []string{"yaml", "yml"}, directive: tinyflags.CompleteFilterExt},
+		{name: "directories", args: []string{"--dir", ""}, want: nil, directive: tinyflags.CompleteFilterDirs},
+		{name: "plain value", args: []string{"proxy", "--http.a.port", ""}, want: nil, directive: tinyflags.CompleteDefault},
+		{name: "flag names", args: []string{"get", "--o"}, want: []string{"--output"}, directive: tinyflags.CompleteNoFile},
+		{name: "inherited short names", args: []string{"get", "-"}, want: []string{"--output", "-o", "--label", "--help", "-h", "--namespace", "-n", "--config", "--dir"}, directive: tinyflags.CompleteNoFile},
+		{name: "dynamic group prefix", args: []string{"proxy", "--h"}, want: []string{"--help", "--http."}, directive: tinyflags.CompleteNoFile | tinyflags.CompleteNoSpace},
+		{name: "dynamic ids", args: []string{"proxy", "--http.a.port=1", "--http.b.addr", "x", "--http."}, want: []string{"--http.a.", "--http.b."}, directive: tinyflags.CompleteNoFile | tinyflags.CompleteNoSpace},
+		{name: "dynamic fields", args: []string{"proxy", "--http.new."}, want: []string{"--http.new.addr", "--http.new.port"}, directive: tinyflags.CompleteNoFile},
+		{name: "dynamic value", args: []string{"proxy", "--http.new.addr", ""}, want: []string{"localhost:80"}, directive: tinyflags.CompleteNoFile},
+		{name: "leaf without completer", args: []string{"proxy", ""}, want: nil, directive: tinyflags.CompleteDefault},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			comps, directive := newRuntimeCompletionApp().Completions(ctx, tc.args)
+			assert.Equal(t, tc.want, values(comps))
+			assert.Equal(t, tc.directive, directive)
+		})
+	}
+}
+
+// TestCompleteProtocol verifies the hidden __complete entry point.
+func TestCompleteProtocol(t *testing.T) {
+	t.Parallel()
+
+	t.Run("writes candidates and directive", func(t *testing.T) {
+		t.Parallel()
+
+		root := newRuntimeCompletionApp()
+		var out bytes.Buffer
+		root.SetOutput(&out)
+
+		err := root.Parse([]string{"__complete", "--namespace", `""`})
+		require.Error(t, err)
+		assert.True(t, tinyflags.IsCompletionRequested(err))
+		assert.Equal(t, "default\tDefault namespace\ndev\nkube-system\tSystem components\n:4\n", out.String())
+	})
+
+	t.Run("does not run handlers or validation", func(t *testing.T) {
+		t.Parallel()
+
+		root := newRuntimeCompletionApp()
+		root.Globals().String("token", "", "Token").Required()
+		var out bytes.Buffer
+		root.SetOutput(&out)
+
+		_, err := root.ParseRunner([]string{"__complete", "get", ""})
+		require.True(t, tinyflags.IsCompletionRequested(err))
+		assert.Equal(t, "pods\nservices\n:4\n", out.String())
+	})
+
+	t.Run("only on root command", func(t *testing.T) {
+		t.Parallel()
+
+		root := newRuntimeCompletionApp()
+		err := root.Parse([]string{"get", "__complete"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"__complete"}, root.SelectedCommand().Args())
+	})
+}
+
+// TestBashRuntimeCompletion runs the bash helper against a fake program that answers __complete.
+func TestBashRuntimeCompletion(t *testing.T) {
+	t.Parallel()
+
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		t.Skip("bash not available")
+	}
+
+	dir := t.TempDir()
+	var buf bytes.Buffer
+	require.NoError(t, newRuntimeCompletionApp().GenCompletion(&buf, "bash"))
+	script := filepath.Join(dir, "kube.bash")
+	require.NoError(t, os.WriteFile(script, buf.Bytes(), 0o600))
+
+	run := func(t *testing.T, reply string, words ...string) ([]string, string) {
+		t.Helper()
+		fake := filepath.Join(t.TempDir(), "kube")
+		argsFile := fake + ".args"
+		body := "#!/bin/sh\nprintf '%s\\n' \"$@\" > " + strconv.Quote(argsFile) + "\nprintf '%b' " + strconv.Quote(reply) + "\n"
+		require.NoError(t, os.WriteFile(fake, []byte(body), 0o700))
+
+		words[0] = fake
+		quoted := make([]string, len(words))
+		for i, w := range words {
+			quoted[i] = strconv.Quote(w)
+		}
+		cmd := exec.Command(bash, "--norc", "--noprofile", "-c",
+			"source "+strconv.Quote(script)+
+				"; COMP_WORDS=("+strings.Join(quoted, " ")+")"+
+				"; COMP_CWORD="+strconv.Itoa(len(words)-1)+
+				"; _kube_completion; printf '%s\\n' \"${COMPREPLY[@]}\"")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		args, _ := os.ReadFile(argsFile)
+		return strings.Fields(string(out)), string(args)
+	}
+
+	t.Run("value completer", func(t *testing.T) {
+		t.Parallel()
+		got, args := run(t, `dev\tDev\ndefault\n:4\n`, "kube", "--namespace", "d")
+		assert.Equal(t, []string{"dev", "default"}, got)
+		assert.Equal(t, "__complete\n--namespace\nd\n", args)
+	})
+
+	t.Run("glued equals", func(t *testing.T) {
+		t.Parallel()
+		got, args := run(t, `--namespace=dev\n:4\n`, "kube", "--namespace", "=", "d")
+		assert.Equal(t, []string{"dev"}, got)
+		assert.Equal(t, "__complete\n--namespace=d\n", args)
+	})
+
+	t.Run("positional completer", func(t *testing.T) {
+		t.Parallel()
+		got, args := run(t, `pods\n:4\n`, "kube", "get", "p")
+		assert.Equal(t, []string{"pods"}, got)
+		assert.Equal(t, "__complete\nget\np\n", args)
+	})
+
+	t.Run("static parts stay static", func(t *testing.T) {
+		t.Parallel()
+		got, args := run(t, `never\n:4\n`, "kube", "get", "-o", "")
+		assert.Equal(t, []string{"text", "json"}, got)
+		assert.Empty(t, args)
+	})
+}
@@ -0,0 +1,197 @@
+package tinyflags_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCompletionApp builds a small command tree used by completion tests.
+func newCompletionApp() *tinyflags.Command {
+	root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+	root.Globals().Bool("verbose", false, "Verbose output").Short("v")
+	root.Globals().String("config", "", "Config file").Short("c")
+	root.Globals().String("secret", "", "Secret").Hidden()
+
+	serve := root.Command("serve", "Run the server")
+	serve.Int("port", 8080, "API port").Short("p")
+	tinyflags.Enum(serve.FlagSet, "format", "json", "Output format", "json", "yaml")
+
+	admin := root.Command("admin", "Admin tools")
+	admin.Globals().Bool("audit", false, "Audit mode")
+	users := admin.Command("users", "Manage users")
+	users.String("role", "user", "Role").Choices("user", "admin")
+
+	root.AddCompletionCommand()
+	return root
+}
+
+// TestCompletionScripts verifies the generated scripts cover commands, flags and values.
+func TestCompletionScripts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all shells render", func(t *testing.T) {
+		t.Parallel()
+
+		root := newCompletionApp()
+		for _, shell := range tinyflags.CompletionShells() {
+			var buf bytes.Buffer
+			require.NoError(t, root.GenCompletion(&buf, shell), shell)
+			script := buf.String()
+			assert.Contains(t, script, "completion for app", shell)
+			assert.Contains(t, script, "serve", shell)
+			assert.Contains(t, script, "users", shell)
+			assert.Contains(t, script, "yaml", shell)
+			assert.Contains(t, script, "verbose", shell)
+			assert.NotContains(t, script, "secret", shell)
+			assert.NotContains(t, script, "Generate shell completion scripts", shell)
+		}
+	})
+
+	t.Run("shell specific registration", func(t *testing.T) {
+		t.Parallel()
+
+		root := newCompletionApp()
+		cases := map[string]string{
+			"bash":       "complete -o default -F _app_completion app",
+			"zsh":        "compdef _app app",
+			"fish":       "complete -c app -n '__app_using \\'app serve\\'' -l 'format' -x -a 'json yaml' -d 'Output format'",
+			"powershell": "Register-ArgumentCompleter -Native -CommandName 'app'",
+		}
+		for shell, want := range cases {
+			var buf bytes.Buffer
+			require.NoError(t, root.GenCompletion(&buf, shell))
+			assert.Contains(t, buf.String(), want, shell)
+		}
+	})
+
+	t.Run("unsupported shell", func(t *testing.T) {
+		t.Parallel()
+
+		root := newCompletionApp()
+		err := root.GenCompletion(&bytes.Buffer{}, "tcsh")
+		require.Error(t, err)
+		assert.EqualError(t, err, `unsupported shell "tcsh" (supported: bash, zsh, fish, powershell)`)
+	})
+
+	t.Run("subcommand renders whole tree", func(t *testing.T) {
+		t.Parallel()
+
+		root := newCompletionApp()
+		users := root.Commands()[1].Commands()[0]
+		var fromRoot, fromChild bytes.Buffer
+		require.NoError(t, root.GenCompletion(&fromRoot, "bash"))
+		require.NoError(t, users.GenCompletion(&fromChild, "bash"))
+		assert.Equal(t, fromRoot.String(), fromChild.String())
+	})
+}
+
+// TestCompletionCommand verifies the hidden completion subcommand.
+func TestCompletionCommand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("prints script", func(t *testing.T) {
+		t.Parallel()
+
+		root := newCompletionApp()
+		var out bytes.Buffer
+		root.SetOutput(&out)
+
+		runner, err := root.ParseRunner([]string{"completion", "zsh"})
+		require.NoError(t, err)
+		require.NoError(t, runner.Run(context.Background()))
+		assert.True(t, strings.HasPrefix(out.String(), "#compdef app\n"))
+	})
+
+	t.Run("hidden from help", func(t *testing.T) {
+		t.Parallel()
+
+		root := newCompletionApp()
+		err := root.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "serve")
+		assert.NotContains(t, err.Error(), "completion")
+	})
+
+	t.Run("rejects unknown shell", func(t *testing.T) {
+		t.Parallel()
+
+		root := newCompletionApp()
+		runner, err := root.ParseRunner([]string{"completion", "tcsh"})
+		require.NoError(t, err)
+		err = runner.Run(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `completion: unsupported shell "tcsh"`)
+	})
+
+	t.Run("requires shell", func(t *testing.T) {
+		t.Parallel()
+
+		root := newCompletionApp()
+		_, err := root.ParseRunner([]string{"completion"})
+		require.Error(t, err)
+	})
+}
+
+// TestBashCompletionBehavior runs the generated bash script against simulated command lines.
+func TestBashCompletionBehavior(t *testing.T) {
+	t.Parallel()
+
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		t.Skip("bash not available")
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, newCompletionApp().GenCompletion(&buf, "bash"))
+	script := filepath.Join(t.TempDir(), "app.bash")
+	require.NoError(t, os.WriteFile(script, buf.Bytes(), 0o600))
+
+	complete := func(t *testing.T, words ...string) []string {
+		t.Helper()
+		quoted := make([]string, len(words))
+		for i, w := range words {
+			quoted[i] = strconv.Quote(w)
+		}
+		cmd := exec.Command(bash, "--norc", "--noprofile", "-c",
+			"source "+strconv.Quote(script)+
+				"; COMP_WORDS=("+strings.Join(quoted, " ")+")"+
+				"; COMP_CWORD="+strconv.Itoa(len(words)-1)+
+				"; _app_completion; printf '%s\\n' \"${COMPREPLY[@]}\"")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.Fields(string(out))
+	}
+
+	cases := []struct {
+		name  string
+		words []string
+		want  []string
+	}{
+		{name: "root commands", words: []string{"app", ""}, want: []string{"serve", "admin"}},
+		{name: "root flags", words: []string{"app", "--"}, want: []string{"--verbose", "--config", "--help"}},
+		{name: "command prefix", words: []string{"app", "se"}, want: []string{"serve"}},
+		{name: "inherited flags", words: []string{"app", "serve", "--"}, want: []string{"--port", "--format", "--help", "--verbose", "--config"}},
+		{name: "enum values", words: []string{"app", "serve", "--format", ""}, want: []string{"json", "yaml"}},
+		{name: "short alias values", words: []string{"app", "admin", "users", "--role", "a"}, want: []string{"admin"}},
+		{name: "value after equals", words: []string{"app", "serve", "--format", "=", "y"}, want: []string{"yaml"}},
+		{name: "value flag skipped", words: []string{"app", "--config", "serve", "admin", ""}, want: []string{"users"}},
+		{name: "nested commands", words: []string{"app", "-v", "admin", ""}, want: []string{"users"}},
+		{name: "free value", words: []string{"app", "serve", "--port", ""}, want: nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ElementsMatch(t, tc.want, complete(t, tc.words...))
+		})
+	}
+}
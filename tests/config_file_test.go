@@ -0,0 +1,339 @@
+package tinyflags_test
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeConfig writes content to a temporary config file and returns its path.
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// TestConfigFilePrecedence verifies CLI > env > config > default.
+func TestConfigFilePrecedence(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, `{"host": "config.local", "port": 9000, "user": "cfg", "debug": true}`)
+
+	fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+	fs.EnvPrefix("APP")
+	fs.SetGetEnvFn(func(key string) string {
+		if key == "APP_USER" {
+			return "env"
+		}
+		return ""
+	})
+	fs.ConfigFile(path)
+	host := fs.String("host", "localhost", "Host")
+	port := fs.Int("port", 8080, "Port")
+	user := fs.String("user", "nobody", "User")
+	debug := fs.Bool("debug", false, "Debug")
+	level := fs.String("level", "info", "Level")
+
+	require.NoError(t, fs.Parse([]string{"--host", "cli.local"}))
+	assert.Equal(t, "cli.local", *host.Value())
+	assert.Equal(t, "env", *user.Value())
+	assert.Equal(t, 9000, *port.Value())
+	assert.True(t, *debug.Value())
+	assert.True(t, port.Changed())
+	assert.Equal(t, "info", *level.Value())
+	assert.False(t, level.Changed())
+}
+
+// TestConfigFileValues verifies slices, nested keys and dynamic groups.
+func TestConfigFileValues(t *testing.T) {
+	t.Parallel()
+
+	t.Run("slices", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{"tags": ["a,b", "c"], "ports": "80,443", "single": ["x"]}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		tags := fs.StringSlice("tags", []string{"default"}, "Tags").Delimiter(";")
+		ports := fs.IntSlice("ports", nil, "Ports")
+		single := fs.String("single", "", "Single")
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, []string{"a,b", "c"}, *tags.Value())
+		assert.Equal(t, []int{80, 443}, *ports.Value())
+		assert.Equal(t, "x", *single.Value())
+	})
+
+	t.Run("cli replaces config slice", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{"tags": ["a", "b"]}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		tags := fs.StringSlice("tags", nil, "Tags")
+
+		require.NoError(t, fs.Parse([]string{"--tags", "z"}))
+		assert.Equal(t, []string{"z"}, *tags.Value())
+	})
+
+	t.Run("nested keys", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{"db": {"user": "admin", "pool": {"size": 5}}, "log.level": "debug"}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		user := fs.String("db.user", "", "DB user")
+		size := fs.Int("db.pool.size", 1, "Pool size")
+		level := fs.String("log.level", "info", "Log level")
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, "admin", *user.Value())
+		assert.Equal(t, 5, *size.Value())
+		assert.Equal(t, "debug", *level.Value())
+	})
+
+	t.Run("dynamic groups", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{
+			"http": {
+				"alpha": {"port": 8081, "tags": ["a", "b"]},
+				"beta": {"port": 8082}
+			},
+			"http.gamma.port": 8083
+		}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		http := fs.DynamicGroup("http")
+		port := http.Int("port", 80, "Port")
+		tags := http.StringSlice("tags", nil, "Tags")
+
+		require.NoError(t, fs.Parse([]string{"--http.beta.port=9000"}))
+		assert.Equal(t, map[string]int{"alpha": 8081, "beta": 9000, "gamma": 8083}, port.Values())
+		assert.Equal(t, map[string][]string{"alpha": {"a", "b"}}, tags.Values())
+	})
+
+	t.Run("maps as objects or lists", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{
+			"label": {"env": "prod", "replicas": 3},
+			"annotation": ["team=core", "tier=web"],
+			"route": {"api": {"header": {"X-Env": "prod"}}}
+		}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		label := fs.StringMap("label", map[string]string{"env": "dev"}, "Labels").Value()
+		annotation := fs.StringMap("annotation", nil, "Annotations").Value()
+		header := fs.DynamicGroup("route").StringMap("header", nil, "Headers")
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, map[string]string{"env": "prod", "replicas": "3"}, *label)
+		assert.Equal(t, map[string]string{"team": "core", "tier": "web"}, *annotation)
+		assert.Equal(t, map[string]string{"X-Env": "prod"}, header.MustGet("api"))
+	})
+
+	t.Run("disabled flags are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{"secret": "leaked", "help": true}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		secret := fs.String("secret", "", "Secret").DisableConfig()
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Empty(t, *secret.Value())
+	})
+}
+
+// TestConfigFileSource verifies path resolution and error reporting.
+func TestConfigFileSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("path from flag", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{"name": "from-file"}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.String("config", "", "Config file")
+		fs.ConfigFileFlag("config")
+		name := fs.String("name", "", "Name")
+
+		require.NoError(t, fs.Parse([]string{"--config", path}))
+		assert.Equal(t, "from-file", *name.Value())
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Empty(t, *name.Value())
+	})
+
+	t.Run("flag overrides fixed path", func(t *testing.T) {
+		t.Parallel()
+
+		fixed := writeConfig(t, `{"name": "fixed"}`)
+		chosen := writeConfig(t, `{"name": "chosen"}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(fixed)
+		fs.String("config", "", "Config file")
+		fs.ConfigFileFlag("config")
+		name := fs.String("name", "", "Name")
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, "fixed", *name.Value())
+		require.NoError(t, fs.Parse([]string{"--config=" + chosen}))
+		assert.Equal(t, "chosen", *name.Value())
+	})
+
+	t.Run("missing default file is ignored", func(t *testing.T) {
+		t.Parallel()
+
+		missing := filepath.Join(t.TempDir(), "missing.json")
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.String("config", missing, "Config file")
+		fs.ConfigFileFlag("config")
+
+		require.NoError(t, fs.Parse(nil))
+	})
+
+	t.Run("missing explicit file fails", func(t *testing.T) {
+		t.Parallel()
+
+		missing := filepath.Join(t.TempDir(), "missing.json")
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.String("config", "", "Config file")
+		fs.ConfigFileFlag("config")
+
+		err := fs.Parse([]string{"--config", missing})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "config file:")
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{"port": `)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		fs.Int("port", 0, "Port")
+
+		err := fs.Parse(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "config file "+path)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{"port": "eighty", "name": ["a", "b"]}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		fs.Int("port", 0, "Port")
+
+		err := fs.Parse(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid value for flag --port from config")
+
+		fs = tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		fs.String("name", "", "Name")
+
+		err = fs.Parse(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected a single value, got a list of 2")
+	})
+
+	t.Run("config satisfies required", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, `{"token": "abc"}`)
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		fs.String("token", "", "Token").Required()
+
+		require.NoError(t, fs.Parse(nil))
+	})
+
+	t.Run("custom decoder", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeConfig(t, "name=custom\n")
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.ConfigFile(path)
+		fs.SetConfigDecoder(tinyflags.ConfigDecoderFunc(func(r io.Reader) (map[string]any, error) {
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return nil, err
+			}
+			key, val, _ := strings.Cut(strings.TrimSpace(string(data)), "=")
+			return map[string]any{key: val}, nil
+		}))
+		name := fs.String("name", "", "Name")
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, "custom", *name.Value())
+	})
+
+	t.Run("json decoder keeps numbers exact", func(t *testing.T) {
+		t.Parallel()
+
+		doc, err := tinyflags.JSONDecoder{}.Decode(strings.NewReader(`{"big": 9007199254740993}`))
+		require.NoError(t, err)
+		assert.Equal(t, json.Number("9007199254740993"), doc["big"])
+	})
+}
+
+// TestConfigFileCommands verifies command-scoped config sections.
+func TestConfigFileCommands(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, `{
+		"verbose": true,
+		"remote": {
+			"timeout": "5s",
+			"add": {"name": "origin", "url": "https://example.com"}
+		},
+		"add": {"name": "ignored"}
+	}`)
+
+	newApp := func() (*tinyflags.Command, *bool, *string, *string, *string) {
+		root := tinyflags.NewCommand("git", tinyflags.ContinueOnError)
+		root.Globals().String("config", path, "Config file")
+		root.ConfigFileFlag("config")
+		verbose := root.Globals().Bool("verbose", false, "Verbose").Value()
+
+		remote := root.Command("remote", "Manage remotes")
+		timeout := remote.Globals().String("timeout", "1s", "Timeout").Value()
+		add := remote.Command("add", "Add a remote")
+		name := add.String("name", "", "Remote name").Value()
+		url := add.String("url", "", "Remote URL").Value()
+		return root, verbose, timeout, name, url
+	}
+
+	t.Run("sections follow the command path", func(t *testing.T) {
+		t.Parallel()
+
+		root, verbose, timeout, name, url := newApp()
+		require.NoError(t, root.Parse([]string{"remote", "add", "--name", "upstream"}))
+		assert.True(t, *verbose)
+		assert.Equal(t, "5s", *timeout)
+		assert.Equal(t, "upstream", *name)
+		assert.Equal(t, "https://example.com", *url)
+	})
+
+	t.Run("unselected sections are ignored", func(t *testing.T) {
+		t.Parallel()
+
+		root, verbose, timeout, name, _ := newApp()
+		require.NoError(t, root.Parse(nil))
+		assert.True(t, *verbose)
+		assert.Equal(t, "1s", *timeout)
+		assert.Empty(t, *name)
+	})
+}
@@ -0,0 +1,165 @@
+package tinyflags_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type region struct {
+	Cloud string
+	Zone  string
+}
+
+func parseRegion(s string) (region, error) {
+	cloud, zone, ok := strings.Cut(s, "/")
+	if !ok || cloud == "" || zone == "" {
+		return region{}, errors.New("expected CLOUD/ZONE")
+	}
+	return region{Cloud: cloud, Zone: zone}, nil
+}
+
+func formatRegion(r region) string {
+	if r.Cloud == "" {
+		return ""
+	}
+	return r.Cloud + "/" + r.Zone
+}
+
+// TestCustomValue verifies user-defined scalar, slice, and dynamic flag types.
+func TestCustomValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scalar parses and validates", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		var r region
+		tinyflags.Var(fs, &r, "region", region{Cloud: "aws", Zone: "eu-1"}, "Deployment region", parseRegion, formatRegion).
+			Validate(func(r region) error {
+				if r.Cloud != "aws" && r.Cloud != "gcp" {
+					return fmt.Errorf("unsupported cloud %q", r.Cloud)
+				}
+				return nil
+			})
+
+		require.NoError(t, fs.Parse([]string{"--region=gcp/us-2"}))
+		assert.Equal(t, region{Cloud: "gcp", Zone: "us-2"}, r)
+
+		err := fs.Parse([]string{"--region=azure/x"})
+		require.Error(t, err)
+		assert.EqualError(t, err, `invalid value for flag --region: unsupported cloud "azure"`)
+
+		err = fs.Parse([]string{"--region=nope"})
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid value for flag --region: expected CLOUD/ZONE")
+	})
+
+	t.Run("scalar reads env and renders help", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.EnvPrefix("APP")
+		fs.SetGetEnvFn(func(key string) string {
+			if key == "APP_REGION" {
+				return "aws/eu-2"
+			}
+			return ""
+		})
+		r := tinyflags.Custom(fs, "region", region{Cloud: "aws", Zone: "eu-1"}, "Deployment region", parseRegion, formatRegion).
+			Placeholder("CLOUD/ZONE").
+			Value()
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, region{Cloud: "aws", Zone: "eu-2"}, *r)
+
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "--region CLOUD/ZONE")
+		assert.Contains(t, err.Error(), "(default: aws/eu-1)")
+		assert.Contains(t, err.Error(), "(env: APP_REGION)")
+	})
+
+	t.Run("scalar choices use formatter", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		tinyflags.Custom(fs, "region", region{}, "Deployment region", parseRegion, formatRegion).
+			Choices(region{Cloud: "aws", Zone: "eu-1"}, region{Cloud: "gcp", Zone: "us-2"})
+
+		err := fs.Parse([]string{"--region=aws/eu-9"})
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid value for flag --region: must be one of: aws/eu-1, gcp/us-2")
+	})
+
+	t.Run("nil format falls back to fmt", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		tinyflags.Custom(fs, "region", region{Cloud: "aws", Zone: "eu-1"}, "Deployment region", parseRegion, nil)
+
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "(default: {aws eu-1})")
+	})
+
+	t.Run("nil parse panics", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		assert.PanicsWithValue(t, `tinyflags: parse function for flag "region" cannot be nil`, func() {
+			tinyflags.Custom[region](fs, "region", region{}, "Deployment region", nil, formatRegion)
+		})
+	})
+
+	t.Run("slice finalizes each element", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		regions := tinyflags.CustomSlice(fs, "region", nil, "Regions", parseRegion, formatRegion).
+			Finalize(func(r region) region {
+				r.Zone = strings.ToUpper(r.Zone)
+				return r
+			}).
+			Value()
+
+		require.NoError(t, fs.Parse([]string{"--region=aws/eu-1, gcp/us-2", "--region=aws/eu-3"}))
+		assert.Equal(t, []region{
+			{Cloud: "aws", Zone: "EU-1"},
+			{Cloud: "gcp", Zone: "US-2"},
+			{Cloud: "aws", Zone: "EU-3"},
+		}, *regions)
+	})
+
+	t.Run("dynamic scalar and slice", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		clusters := fs.DynamicGroup("cluster")
+		home := tinyflags.DynamicVar(clusters, "home", region{Cloud: "aws", Zone: "eu-1"}, "Home region", parseRegion, formatRegion)
+		replicas := tinyflags.DynamicSliceVar(clusters, "replica", nil, "Replica regions", parseRegion, formatRegion)
+
+		err := fs.Parse([]string{
+			"--cluster.a.home=gcp/us-2",
+			"--cluster.a.replica=aws/eu-1,aws/eu-2",
+			"--cluster.b.replica=gcp/us-1",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, region{Cloud: "gcp", Zone: "us-2"}, home.MustGet("a"))
+		got, ok := home.Get("b")
+		assert.False(t, ok)
+		assert.Equal(t, region{Cloud: "aws", Zone: "eu-1"}, got)
+		assert.Equal(t, []region{{Cloud: "aws", Zone: "eu-1"}, {Cloud: "aws", Zone: "eu-2"}}, replicas.MustGet("a"))
+		assert.Equal(t, []region{{Cloud: "gcp", Zone: "us-1"}}, replicas.MustGet("b"))
+
+		err = fs.Parse([]string{"--cluster.a.home=bad"})
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid value for flag --cluster.a.home: expected CLOUD/ZONE")
+	})
+}
@@ -0,0 +1,109 @@
+package tinyflags_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDecodeGroup verifies decoding dynamic group instances into structs.
+func TestDecodeGroup(t *testing.T) {
+	t.Parallel()
+
+	type httpTarget struct {
+		Address string        `flag:"address"`
+		Timeout time.Duration `flag:"timeout"`
+		Headers []string
+		Note    string `flag:"-"`
+	}
+
+	newGroup := func() (*tinyflags.FlagSet, *tinyflags.DynamicGroup) {
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		http := fs.DynamicGroup("http")
+		http.String("address", "", "Target address")
+		http.Duration("timeout", 2*time.Second, "Request timeout")
+		http.StringSlice("headers", nil, "Extra headers")
+		return fs, http
+	}
+
+	t.Run("map with defaults", func(t *testing.T) {
+		t.Parallel()
+
+		fs, http := newGroup()
+		require.NoError(t, fs.Parse([]string{
+			"--http.a.address=a.example",
+			"--http.b.address=b.example",
+			"--http.b.timeout=5s",
+			"--http.b.headers=x,y",
+		}))
+
+		targets, err := tinyflags.DecodeGroup[httpTarget](http)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]httpTarget{
+			"a": {Address: "a.example", Timeout: 2 * time.Second},
+			"b": {Address: "b.example", Timeout: 5 * time.Second, Headers: []string{"x", "y"}},
+		}, targets)
+	})
+
+	t.Run("ordered", func(t *testing.T) {
+		t.Parallel()
+
+		fs, http := newGroup()
+		require.NoError(t, fs.Parse([]string{"--http.z.address=z", "--http.m.address=m"}))
+
+		targets, err := tinyflags.DecodeGroupOrdered[httpTarget](http)
+		require.NoError(t, err)
+		require.Len(t, targets, 2)
+		assert.Equal(t, "m", targets[0].ID)
+		assert.Equal(t, "m", targets[0].Value.Address)
+		assert.Equal(t, "z", targets[1].ID)
+	})
+
+	t.Run("no instances", func(t *testing.T) {
+		t.Parallel()
+
+		fs, http := newGroup()
+		require.NoError(t, fs.Parse(nil))
+
+		targets, err := tinyflags.DecodeGroup[httpTarget](http)
+		require.NoError(t, err)
+		assert.Empty(t, targets)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		fs, http := newGroup()
+		require.NoError(t, fs.Parse([]string{"--http.a.address=a"}))
+
+		_, err := tinyflags.DecodeGroup[struct {
+			Timeout string `flag:"timeout"`
+		}](http)
+		assert.EqualError(t, err, "cannot decode --http.a.timeout into field Timeout: type time.Duration is not assignable to string")
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		t.Parallel()
+
+		fs, http := newGroup()
+		require.NoError(t, fs.Parse([]string{"--http.a.address=a"}))
+
+		_, err := tinyflags.DecodeGroup[struct {
+			Port int
+		}](http)
+		assert.EqualError(t, err, `cannot decode field Port: dynamic flag "port" is not registered in group "http"`)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		t.Parallel()
+
+		fs, http := newGroup()
+		require.NoError(t, fs.Parse([]string{"--http.a.address=a"}))
+
+		_, err := tinyflags.DecodeGroup[string](http)
+		assert.EqualError(t, err, `cannot decode dynamic group "http" into string: not a struct`)
+	})
+}
@@ -0,0 +1,141 @@
+package tinyflags_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenMarkdown verifies Markdown reference pages.
+func TestGenMarkdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("root page links children", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, newManApp().GenMarkdown(&buf))
+		out := buf.String()
+
+		assert.True(t, strings.HasPrefix(out, "# app\n\n## Synopsis\n\n```\napp [flags] <command>\n```\n"), out)
+		assert.Contains(t, out, "App manages services.\n\nIt talks to the -api endpoint.\n")
+		assert.Contains(t, out, "| Flag | Short | Type | Description | Default | Env | Required | Deprecated | Allowed |\n")
+		assert.Contains(t, out, "| `--verbose` | `-v` | `bool` | Verbose output |  | `APP_VERBOSE` |  |  |  |\n")
+		assert.Contains(t, out, "## Commands\n\n| Command | Summary |\n| :--- | :--- |\n| [serve](app-serve.md) | Start the server |\n")
+		assert.Contains(t, out, "## See Also\n\n- [app serve](app-serve.md) - Start the server\n")
+		assert.NotContains(t, out, "completion")
+	})
+
+	t.Run("child page tables", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, newManApp().Commands()[0].GenMarkdown(&buf))
+		out := buf.String()
+
+		assert.Contains(t, out, "# app serve\n\nStart the server\n")
+		assert.Contains(t, out, "| `--port PORT` | `-p` | `int` | Listen port | `8080` |  |  |  |  |\n")
+		assert.Contains(t, out, "| `--mode <fast\\|slow>` |  | `string` | Mode | `fast` |  |  | use --profile | `fast`, `slow` |\n")
+		assert.Contains(t, out, "| `--token TOKEN` |  | `string` | Token |  |  | yes |  |  |\n")
+		assert.Contains(t, out, "## Global Flags\n\n")
+		assert.Contains(t, out, "### HTTP targets\n\nPattern: `--http.<ID>.<field>`\n\nProbe HTTP endpoints.\n\n| Flag | Type | Description | Default | Env | Required | Deprecated | Allowed |\n")
+		assert.Contains(t, out, "| `--http.<ID>.timeout TIMEOUT` | `int` | Timeout seconds | `5` |  |  |  |  |\n")
+		assert.NotContains(t, out, "debug")
+		assert.Contains(t, out, "| TLS source | one of, required | `--cert`, `--key` |\n| auth | all or none | `--user`, `--pass` |\n")
+		assert.Contains(t, out, "## See Also\n\n- [app](app.md)\n")
+	})
+
+	t.Run("flag set", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("tool", tinyflags.ContinueOnError)
+		fs.EnvPrefix("TOOL")
+		fs.StringSlice("tag", []string{"a", "b"}, "Tags")
+		fs.Counter("verbose", 0, "Verbosity").Short("v")
+		fs.Duration("wait", 0, "Wait").Requires("tag")
+		http := fs.DynamicGroup("http")
+		http.Bool("tls", false, "Use TLS").Strict()
+
+		var buf bytes.Buffer
+		require.NoError(t, fs.GenMarkdown(&buf))
+		out := buf.String()
+
+		assert.True(t, strings.HasPrefix(out, "# tool\n\n## Synopsis\n\n```\ntool [flags]\n```\n"), out)
+		assert.Contains(t, out, "| `--tag TAG...` |  | `[]string` | Tags | `a,b` | `TOOL_TAG` |  |  |  |\n")
+		assert.Contains(t, out, "| `--verbose` | `-v` | `counter` | Verbosity |  | `TOOL_VERBOSE` |  |  |  |\n")
+		assert.Contains(t, out, "| `--wait WAIT` |  | `time.Duration` | Wait (requires: --tag) | `0s` | `TOOL_WAIT` |  |  |  |\n")
+		assert.Contains(t, out, "| `--http.<ID>.tls <true\\|false>` | `bool` | Use TLS | `false` | `TOOL_HTTP_<ID>_TLS` |  |  | `true`, `false` |\n")
+		assert.NotContains(t, out, "See Also")
+	})
+}
+
+// TestGenMarkdownTree verifies that one file is written per visible command.
+func TestGenMarkdownTree(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "docs")
+	require.NoError(t, newManApp().GenMarkdownTree(dir))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.Equal(t, []string{"app-serve.md", "app.md"}, names)
+
+	child, err := os.ReadFile(filepath.Join(dir, "app-serve.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(child), "- [app](app.md)\n")
+}
+
+// TestGenHTML verifies the self-contained HTML reference.
+func TestGenHTML(t *testing.T) {
+	t.Parallel()
+
+	t.Run("command tree", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, newManApp().GenHTML(&buf))
+		out := buf.String()
+
+		assert.True(t, strings.HasPrefix(out, "<!DOCTYPE html>\n"))
+		assert.Contains(t, out, "<title>app reference</title>")
+		assert.Contains(t, out, "<nav>\n<ul>\n<li><a href=\"#app\">app</a>\n<ul>\n<li><a href=\"#app-serve\">app serve</a>\n")
+		assert.Contains(t, out, "<section id=\"app\">\n<h2>app</h2>\n")
+		assert.Contains(t, out, "<section id=\"app-serve\">\n<h2>app serve</h2>\n<p class=\"summary\">Start the server</p>\n<p>Parent: <a href=\"#app\">app</a></p>\n")
+		assert.Contains(t, out, "<td><code>--mode &lt;fast|slow&gt;</code></td><td></td><td><code>string</code></td><td>Mode</td>")
+		assert.Contains(t, out, "<h4>HTTP targets</h4>\n<p>Pattern: <code>--http.&lt;ID&gt;.&lt;field&gt;</code></p>\n")
+		assert.Contains(t, out, "<td><a href=\"#app-serve\">serve</a></td><td>Start the server</td>")
+		assert.Contains(t, out, "<p>Jane Doe &lt;jane@example.com&gt;</p>")
+		assert.NotContains(t, out, "<script")
+		assert.NotContains(t, out, "<link")
+	})
+
+	t.Run("subtree has no dangling parent link", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, newManApp().Commands()[0].GenHTML(&buf))
+		assert.NotContains(t, buf.String(), "Parent:")
+		assert.NotContains(t, buf.String(), "href=\"#app\"")
+	})
+
+	t.Run("flag set", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("tool", tinyflags.ContinueOnError)
+		fs.String("name", "x", "Name")
+		var buf bytes.Buffer
+		require.NoError(t, fs.GenHTML(&buf))
+		assert.Contains(t, buf.String(), "<section id=\"tool\">")
+		assert.Contains(t, buf.String(), "<td><code>--name NAME</code></td>")
+	})
+}
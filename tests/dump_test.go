@@ -0,0 +1,237 @@
+package tinyflags_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDumpFlagSet registers a representative mix of static and dynamic flags.
+func newDumpFlagSet() *tinyflags.FlagSet {
+	fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+	fs.EnvPrefix("APP")
+	fs.SetGetEnvFn(func(string) string { return "" })
+	fs.String("host", "localhost", "Host")
+	fs.Int("port", 8080, "Port")
+	fs.Bool("debug", false, "Debug")
+	fs.Counter("verbose", 0, "Verbosity").Short("v")
+	fs.Duration("timeout", time.Second, "Timeout")
+	fs.StringSlice("tags", nil, "Tags")
+	fs.String("greeting", "", "Greeting")
+	fs.String("token", "", "Token").OverriddenValueMaskFn(tinyflags.MaskFirstLast)
+	http := fs.DynamicGroup("http")
+	http.Int("port", 80, "Port")
+	http.String("addr", "", "Address")
+	http.Bool("tls", false, "TLS")
+	return fs
+}
+
+// unquoteArgs splits a line produced by DumpArgs back into arguments.
+func unquoteArgs(line string) []string {
+	var args []string
+	var cur strings.Builder
+	inQuote, started := false, false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case inQuote && c == '\'':
+			inQuote = false
+		case inQuote:
+			cur.WriteByte(c)
+		case c == '\'':
+			inQuote, started = true, true
+		case c == '\\' && i+1 < len(line):
+			i++
+			cur.WriteByte(line[i])
+			started = true
+		case c == ' ' || c == '\n':
+			if started {
+				args = append(args, cur.String())
+				cur.Reset()
+				started = false
+			}
+		default:
+			cur.WriteByte(c)
+			started = true
+		}
+	}
+	if started {
+		args = append(args, cur.String())
+	}
+	return args
+}
+
+// TestDump verifies each output format.
+func TestDump(t *testing.T) {
+	t.Parallel()
+
+	args := []string{
+		"--host", "example.com", "--debug", "-vv", "--tags", "a,b", "--tags", "c d",
+		"--greeting", "it's me", "--token", "secret",
+		"--http.alpha.port=8081", "--http.beta.addr=10.0.0.1", "--http.beta.tls",
+	}
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		fs := newDumpFlagSet()
+		require.NoError(t, fs.Parse(args))
+		var out bytes.Buffer
+		require.NoError(t, fs.Dump(&out, tinyflags.DumpJSON))
+		assert.JSONEq(t, `{
+			"host": "example.com",
+			"port": 8080,
+			"debug": true,
+			"verbose": 2,
+			"timeout": "1s",
+			"tags": ["a", "b", "c d"],
+			"greeting": "it's me",
+			"token": "s****t",
+			"http": {
+				"alpha": {"port": 8081, "addr": "", "tls": false},
+				"beta": {"port": 80, "addr": "10.0.0.1", "tls": true}
+			}
+		}`, out.String())
+	})
+
+	t.Run("dotenv", func(t *testing.T) {
+		t.Parallel()
+
+		fs := newDumpFlagSet()
+		require.NoError(t, fs.Parse(args))
+		var out bytes.Buffer
+		require.NoError(t, fs.Dump(&out, tinyflags.DumpDotenv))
+		assert.Equal(t, strings.Join([]string{
+			"APP_HOST=example.com",
+			"APP_PORT=8080",
+			"APP_DEBUG=true",
+			"APP_VERBOSE=2",
+			"APP_TIMEOUT=1s",
+			`APP_TAGS="a,b,c d"`,
+			`APP_GREETING="it's me"`,
+			`APP_TOKEN="s****t"`,
+			"APP_HTTP_ALPHA_PORT=8081",
+			"APP_HTTP_ALPHA_ADDR=",
+			"APP_HTTP_ALPHA_TLS=false",
+			"APP_HTTP_BETA_PORT=80",
+			"APP_HTTP_BETA_ADDR=10.0.0.1",
+			"APP_HTTP_BETA_TLS=true",
+		}, "\n")+"\n", out.String())
+	})
+
+	t.Run("dotenv rejects mixed-case IDs", func(t *testing.T) {
+		t.Parallel()
+
+		fs := newDumpFlagSet()
+		require.NoError(t, fs.Parse([]string{"--http.Primary.port=8081"}))
+		err := fs.Dump(&bytes.Buffer{}, tinyflags.DumpDotenv)
+		require.Error(t, err)
+		assert.EqualError(t, err, `cannot dump --http.Primary.port as dotenv: instance ID "Primary" is not lower-case`)
+
+		var out bytes.Buffer
+		require.NoError(t, fs.Dump(&out, tinyflags.DumpJSON))
+		assert.Contains(t, out.String(), `"Primary"`)
+	})
+
+	t.Run("args", func(t *testing.T) {
+		t.Parallel()
+
+		fs := newDumpFlagSet()
+		require.NoError(t, fs.Parse(args))
+		var out bytes.Buffer
+		require.NoError(t, fs.Dump(&out, tinyflags.DumpArgs))
+		assert.Equal(t, `--host=example.com --debug --verbose --verbose --tags=a --tags=b '--tags=c d' '--greeting=it'\''s me' '--token=s****t' --http.alpha.port=8081 --http.beta.addr=10.0.0.1 --http.beta.tls`+"\n", out.String())
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		fs := newDumpFlagSet()
+		err := fs.Dump(&bytes.Buffer{}, "yaml")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported dump format "yaml"`)
+	})
+}
+
+// TestDumpRoundTrip verifies each format reproduces the same configuration.
+func TestDumpRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	args := []string{
+		"--host", "example.com", "--debug", "-vvv", "--timeout", "2m",
+		"--tags", "x", "--tags", "y z", "--greeting", "it's \"me\" $HOME",
+		"--http.alpha.port=8081", "--http.beta.tls",
+	}
+	source := newDumpFlagSet()
+	require.NoError(t, source.Parse(args))
+	var want bytes.Buffer
+	require.NoError(t, source.Dump(&want, tinyflags.DumpJSON))
+
+	t.Run("args", func(t *testing.T) {
+		t.Parallel()
+
+		var out bytes.Buffer
+		require.NoError(t, source.Dump(&out, tinyflags.DumpArgs))
+		fs := newDumpFlagSet()
+		require.NoError(t, fs.Parse(unquoteArgs(out.String())))
+		var got bytes.Buffer
+		require.NoError(t, fs.Dump(&got, tinyflags.DumpJSON))
+		assert.JSONEq(t, want.String(), got.String())
+	})
+
+	t.Run("json config", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "config.json")
+		require.NoError(t, os.WriteFile(path, want.Bytes(), 0o600))
+		fs := newDumpFlagSet()
+		fs.ConfigFile(path)
+		require.NoError(t, fs.Parse(nil))
+		var got bytes.Buffer
+		require.NoError(t, fs.Dump(&got, tinyflags.DumpJSON))
+		assert.JSONEq(t, want.String(), got.String())
+	})
+}
+
+// TestCommandDump verifies command paths are kept in every format.
+func TestCommandDump(t *testing.T) {
+	t.Parallel()
+
+	newApp := func() *tinyflags.Command {
+		root := tinyflags.NewCommand("git", tinyflags.ContinueOnError)
+		root.EnvPrefix("GIT")
+		root.Globals().Bool("verbose", false, "Verbose")
+		remote := root.Command("remote", "Manage remotes")
+		remote.String("scope", "local", "Scope")
+		add := remote.Command("add", "Add a remote")
+		add.EnvPrefix("GIT_REMOTE_ADD")
+		add.String("name", "", "Name")
+		return root
+	}
+
+	root := newApp()
+	require.NoError(t, root.Parse([]string{"--verbose", "remote", "--scope", "global", "add", "--name", "origin"}))
+
+	var out bytes.Buffer
+	require.NoError(t, root.Dump(&out, tinyflags.DumpArgs))
+	assert.Equal(t, "--verbose remote --scope=global add --name=origin\n", out.String())
+
+	replay := newApp()
+	require.NoError(t, replay.Parse(unquoteArgs(out.String())))
+	assert.Equal(t, "git remote add", replay.SelectedCommand().FullName())
+
+	out.Reset()
+	require.NoError(t, root.Dump(&out, tinyflags.DumpJSON))
+	assert.JSONEq(t, `{"verbose": true, "remote": {"scope": "global", "add": {"name": "origin"}}}`, out.String())
+
+	out.Reset()
+	require.NoError(t, root.Dump(&out, tinyflags.DumpDotenv))
+	assert.Equal(t, "GIT_VERBOSE=true\nGIT_REMOTE_ADD_NAME=origin\n", out.String())
+}
@@ -0,0 +1,258 @@
+package tinyflags_test
+
+import (
+	"errors"
+	"flag"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listValue is a minimal accumulating flag.Value.
+type listValue struct {
+	items []string
+}
+
+func (l *listValue) String() string     { return strings.Join(l.items, "+") }
+func (l *listValue) Set(s string) error { l.items = append(l.items, s); return nil }
+
+// upperValue stores one upper-cased string.
+type upperValue struct {
+	s string
+}
+
+func (u *upperValue) String() string { return u.s }
+func (u *upperValue) Reset()         { u.s = "DEFAULT" }
+func (u *upperValue) Set(s string) error {
+	if s == "" {
+		return errors.New("empty")
+	}
+	u.s = strings.ToUpper(s)
+	return nil
+}
+
+// TestExternalValues verifies TextUnmarshaler, flag.Value and func-backed flags.
+func TestExternalValues(t *testing.T) {
+	t.Parallel()
+
+	t.Run("text var parses and resets", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		var addr netip.Addr
+		fs.TextVar(&addr, "listen", netip.MustParseAddr("127.0.0.1"), "Listen address")
+
+		require.NoError(t, fs.Parse([]string{"--listen", "10.0.0.1"}))
+		assert.Equal(t, netip.MustParseAddr("10.0.0.1"), addr)
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, netip.MustParseAddr("127.0.0.1"), addr)
+
+		err := fs.Parse([]string{"--listen=nope"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid value for flag --listen:")
+	})
+
+	t.Run("text var supports env required and help", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.EnvPrefix("APP")
+		env := map[string]string{}
+		fs.SetGetEnvFn(func(key string) string { return env[key] })
+
+		var addr netip.Addr
+		fs.TextVar(&addr, "listen", netip.MustParseAddr("::1"), "Listen address").Required().Placeholder("ADDR")
+
+		err := fs.Parse(nil)
+		require.Error(t, err)
+		assert.EqualError(t, err, "flag --listen is required")
+
+		env["APP_LISTEN"] = "192.168.0.1"
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, netip.MustParseAddr("192.168.0.1"), addr)
+
+		err = fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "--listen ADDR")
+		assert.Contains(t, err.Error(), "(default: ::1)")
+		assert.Contains(t, err.Error(), "(env: APP_LISTEN)")
+	})
+
+	t.Run("value participates in one-of groups and masking", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		token := &upperValue{}
+		fs.Value(token, "token", "API token").OneOfGroup("auth").OverriddenValueMaskFn(func(any) any { return "***" })
+		fs.String("password", "", "Password").OneOfGroup("auth")
+
+		require.NoError(t, fs.Parse([]string{"--token=abc"}))
+		assert.Equal(t, "ABC", token.s)
+		assert.Equal(t, map[string]any{"token": "***"}, fs.OverriddenValues())
+
+		err := fs.Parse([]string{"--token=abc", "--password=x"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `only one of the flags in group "auth" may be used`)
+	})
+
+	t.Run("value validate runs before set", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		list := &listValue{}
+		fl := fs.Value(list, "item", "Items").Validate(func(s string) error {
+			if strings.Contains(s, " ") {
+				return errors.New("no spaces")
+			}
+			return nil
+		})
+
+		require.NoError(t, fs.Parse([]string{"--item=a", "--item", "b"}))
+		assert.Equal(t, []string{"a", "b"}, list.items)
+		assert.True(t, fl.Changed())
+
+		err := fs.Parse([]string{"--item=a b"})
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid value for flag --item: no spaces")
+	})
+
+	t.Run("accumulating value is left alone", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		tags := &listValue{}
+		fl := fs.Value(tags, "tag", "Tags")
+
+		require.NoError(t, fs.Parse([]string{"--tag=a"}))
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, []string{"a"}, tags.items)
+		assert.False(t, fl.Changed())
+	})
+
+	t.Run("value with Reset is rewound", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		token := &upperValue{s: "DEFAULT"}
+		fl := fs.Value(token, "token", "API token")
+
+		require.NoError(t, fs.Parse([]string{"--token=abc"}))
+		assert.Equal(t, "ABC", token.s)
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, "DEFAULT", token.s)
+		assert.False(t, fl.Changed())
+	})
+
+	t.Run("func and bool func", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		var seen []string
+		fs.Func("load", "Load a file", func(s string) error {
+			seen = append(seen, "load:"+s)
+			return nil
+		}).Short("l")
+		traced := 0
+		fs.BoolFunc("trace", "Enable tracing", func(string) error {
+			traced++
+			return nil
+		})
+
+		require.NoError(t, fs.Parse([]string{"-l", "a.json", "--trace", "--load=b.json", "--trace"}))
+		assert.Equal(t, []string{"load:a.json", "load:b.json"}, seen)
+		assert.Equal(t, 2, traced)
+	})
+
+	t.Run("func slice calls per item", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		var seen []string
+		raw := fs.FuncSlice("tag", "Tags", func(s string) error {
+			if s == "bad" {
+				return errors.New("bad tag")
+			}
+			seen = append(seen, s)
+			return nil
+		}).Value()
+
+		require.NoError(t, fs.Parse([]string{"--tag=a,b", "--tag=c"}))
+		assert.Equal(t, []string{"a", "b", "c"}, seen)
+		assert.Equal(t, []string{"a", "b", "c"}, *raw)
+
+		err := fs.Parse([]string{"--tag=x,bad"})
+		require.Error(t, err)
+		assert.EqualError(t, err, `invalid value for flag --tag: invalid value "bad": bad tag`)
+	})
+
+	t.Run("value slice creates fresh values", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		vals := fs.ValueSlice(func() flag.Value { return &upperValue{} }, "name", "Names").Value()
+
+		require.NoError(t, fs.Parse([]string{"--name=a,b"}))
+		require.Len(t, *vals, 2)
+		assert.Equal(t, "A", (*vals)[0].String())
+		assert.Equal(t, "B", (*vals)[1].String())
+	})
+
+	t.Run("text slice", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		addrs := tinyflags.TextSlice[netip.Addr](fs, "peer", []netip.Addr{netip.MustParseAddr("10.0.0.1")}, "Peers")
+
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "(default: 10.0.0.1)")
+
+		require.NoError(t, fs.Parse([]string{"--peer=10.0.0.2, 10.0.0.3"}))
+		assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.0.0.2"), netip.MustParseAddr("10.0.0.3")}, *addrs.Value())
+	})
+
+	t.Run("dynamic variants", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		peers := fs.DynamicGroup("peer")
+		tinyflags.DynamicText[netip.Addr](peers, "addr", netip.Addr{}, "Peer address").Required()
+		routes := tinyflags.DynamicTextSlice[netip.Addr](peers, "route", nil, "Routes")
+		var hooks []string
+		hook := peers.Func("hook", "Hook", func(s string) error {
+			hooks = append(hooks, s)
+			return nil
+		})
+		name := peers.Value("name", "Name", func() flag.Value { return &upperValue{} })
+		labels := peers.ValueSlice("label", "Labels", func() flag.Value { return &upperValue{} })
+
+		err := fs.Parse([]string{
+			"--peer.a.addr=10.0.0.1",
+			"--peer.a.route=10.1.0.1,10.1.0.2",
+			"--peer.a.hook=x",
+			"--peer.a.name=alpha",
+			"--peer.a.label=l1,l2",
+		})
+		require.NoError(t, err)
+
+		a, err := tinyflags.GetDynamic[netip.Addr](peers, "a", "addr")
+		require.NoError(t, err)
+		assert.Equal(t, netip.MustParseAddr("10.0.0.1"), a)
+		assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.1.0.1"), netip.MustParseAddr("10.1.0.2")}, routes.MustGet("a"))
+		assert.Equal(t, "x", hook.MustGet("a"))
+		assert.Equal(t, []string{"x"}, hooks)
+		assert.Equal(t, "ALPHA", name.MustGet("a").String())
+		require.Len(t, labels.MustGet("a"), 2)
+		assert.Equal(t, "L2", labels.MustGet("a")[1].String())
+
+		err = fs.Parse([]string{"--peer.b.route=10.0.0.9"})
+		require.Error(t, err)
+		assert.EqualError(t, err, "flag --peer.b.addr is required")
+	})
+}
@@ -0,0 +1,123 @@
+package tinyflags_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newManApp builds a command tree covering every man page section.
+func newManApp() *tinyflags.Command {
+	root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+	root.Version("1.2.0")
+	root.EnvPrefix("APP")
+	root.Description("App manages services.\n\nIt talks to the -api endpoint.")
+	root.Authors("Jane Doe <jane@example.com>")
+	root.Globals().Bool("verbose", false, "Verbose output").Short("v")
+
+	serve := root.Command("serve", "Start the server")
+	serve.Note(".dotted note")
+	serve.Int("port", 8080, "Listen port").Short("p")
+	serve.String("mode", "fast", "Mode").Choices("fast", "slow").Deprecated("use --profile")
+	serve.String("token", "", "Token").Required().DisableEnv()
+	serve.String("cert", "", "TLS cert").OneOfGroup("source")
+	serve.String("key", "", "TLS key").OneOfGroup("source")
+	serve.String("user", "", "User").AllOrNone("auth")
+	serve.String("pass", "", "Password").AllOrNone("auth").Placeholder("SECRET")
+	serve.GetOneOfGroup("source").Title("TLS source").Required()
+
+	http := serve.DynamicGroup("http")
+	http.Title("HTTP targets")
+	http.Description("Probe HTTP endpoints.")
+	http.String("addr", "", "Target address")
+	http.Int("timeout", 5, "Timeout seconds")
+	http.Bool("debug", false, "Debug").Hidden()
+
+	root.AddCompletionCommand()
+	return root
+}
+
+// TestGenManPage verifies roff rendering of one command page.
+func TestGenManPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("root page", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		header := tinyflags.ManHeader{Date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Manual: "User Commands"}
+		require.NoError(t, newManApp().GenManPage(&buf, header))
+		out := buf.String()
+
+		assert.True(t, strings.HasPrefix(out, `.TH "APP" "1" "2024\-05\-01" "app 1.2.0" "User Commands"`+"\n"), out)
+		assert.Contains(t, out, ".SH NAME\napp\n")
+		assert.Contains(t, out, ".SH SYNOPSIS\n.B \"app\"\n[flags] <command>\n")
+		assert.Contains(t, out, ".SH DESCRIPTION\nApp manages services.\n.PP\nIt talks to the \\-api endpoint.\n")
+		assert.Contains(t, out, ".TP\n\\fB\\-v\\fR, \\fB\\-\\-verbose\\fR\nVerbose output\n.br\nEnvironment: \\fBAPP_VERBOSE\\fR\n")
+		assert.Contains(t, out, ".SH COMMANDS\n.TP\n\\fBserve\\fR\nStart the server\n")
+		assert.Contains(t, out, ".SH AUTHORS\nJane Doe <jane@example.com>\n")
+		assert.Contains(t, out, ".SH \"SEE ALSO\"\n\\fBapp\\-serve\\fR(1)\n")
+		assert.NotContains(t, out, "completion")
+		assert.NotContains(t, out, "GLOBAL OPTIONS")
+	})
+
+	t.Run("child page", func(t *testing.T) {
+		t.Parallel()
+
+		root := newManApp()
+		var buf bytes.Buffer
+		require.NoError(t, root.Commands()[0].GenManPage(&buf, tinyflags.ManHeader{Section: "8"}))
+		out := buf.String()
+
+		assert.True(t, strings.HasPrefix(out, `.TH "APP\-SERVE" "8" "" "app 1.2.0" ""`+"\n"), out)
+		assert.Contains(t, out, ".SH NAME\napp\\-serve \\- Start the server\n")
+		assert.Contains(t, out, ".B \"app serve\"\n[flags]\n")
+		assert.Contains(t, out, ".TP\n\\fB\\-p\\fR, \\fB\\-\\-port\\fR \\fIPORT\\fR\nListen port\n.br\nDefault: 8080\n")
+		assert.Contains(t, out, ".TP\n\\fB\\-\\-mode\\fR \\fI<fast|slow>\\fR\nMode\n.br\nDeprecated: use \\-\\-profile\n.br\nAllowed: fast, slow\n.br\nDefault: fast\n")
+		assert.Contains(t, out, ".TP\n\\fB\\-\\-token\\fR \\fITOKEN\\fR\nToken\n.br\nRequired.\n")
+		assert.Contains(t, out, "\\fB\\-\\-pass\\fR \\fISECRET\\fR\n")
+		assert.Contains(t, out, ".SH \"GLOBAL OPTIONS\"\n.TP\n\\fB\\-v\\fR, \\fB\\-\\-verbose\\fR\n")
+		assert.Contains(t, out, ".SH \"DYNAMIC OPTIONS\"\n.SS \"HTTP targets\"\nProbe HTTP endpoints.\n.TP\n\\fB\\-\\-http.<ID>.addr\\fR \\fIADDR\\fR\nTarget address\n")
+		assert.Contains(t, out, "\\fB\\-\\-http.<ID>.timeout\\fR \\fITIMEOUT\\fR\nTimeout seconds\n.br\nDefault: 5\n")
+		assert.NotContains(t, out, "debug")
+		assert.Contains(t, out, ".SH \"FLAG GROUPS\"\n.TP\n\\fBTLS source\\fR (one of, required)\n\\-\\-cert, \\-\\-key\n.TP\n\\fBauth\\fR (all or none)\n\\-\\-user, \\-\\-pass\n")
+		assert.Contains(t, out, ".SH NOTES\n\\&.dotted note\n")
+		assert.Contains(t, out, ".SH \"SEE ALSO\"\n\\fBapp\\fR(8)\n")
+		assert.NotContains(t, out, ".SH COMMANDS")
+	})
+
+	t.Run("nil writer", func(t *testing.T) {
+		t.Parallel()
+		assert.NoError(t, newManApp().GenManPage(nil, tinyflags.ManHeader{}))
+	})
+}
+
+// TestGenManTree verifies that one page is written per visible command.
+func TestGenManTree(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "man1")
+	require.NoError(t, newManApp().GenManTree(dir, tinyflags.ManHeader{}))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.Equal(t, []string{"app-serve.1", "app.1"}, names)
+
+	if _, err := exec.LookPath("groff"); err == nil {
+		out, err := exec.Command("groff", "-man", "-Tutf8", "-ww", "-z", filepath.Join(dir, "app-serve.1")).CombinedOutput()
+		require.NoError(t, err, string(out))
+		assert.Empty(t, string(out))
+	}
+}
@@ -0,0 +1,129 @@
+package tinyflags_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// identity parses string keys as-is.
+func identity(s string) (string, error) { return s, nil }
+
+// TestMapFlag verifies key=value map flags.
+func TestMapFlag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("repeated and comma-joined entries", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		labels := fs.StringMap("label", map[string]string{"env": "dev"}, "Labels").Value()
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, map[string]string{"env": "dev"}, *labels)
+
+		require.NoError(t, fs.Parse([]string{"--label", "env=prod", "--label", "team=core, tier = web"}))
+		assert.Equal(t, map[string]string{"env": "prod", "team": "core", "tier": "web"}, *labels)
+	})
+
+	t.Run("custom separator without splitting", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		headers := fs.StringMap("header", nil, "Extra headers").Separator(":").Delimiter("").Value()
+
+		require.NoError(t, fs.Parse([]string{"--header", "X-A: 1", "--header", "Accept: a, b"}))
+		assert.Equal(t, map[string]string{"X-A": "1", "Accept": "a, b"}, *headers)
+	})
+
+	t.Run("typed keys and values", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		var weights map[string]int
+		tinyflags.MapVar(fs, &weights, "weight", nil, "Weights", identity, nil, strconv.Atoi, strconv.Itoa).
+			Validate(func(_ string, v int) error {
+				if v < 0 {
+					return errors.New("must not be negative")
+				}
+				return nil
+			})
+
+		require.NoError(t, fs.Parse([]string{"--weight=a=1,b=2"}))
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, weights)
+
+		err := fs.Parse([]string{"--weight=a=x"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid value "x" for key "a"`)
+
+		err = fs.Parse([]string{"--weight=a=-1"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid entry "a=-1": must not be negative`)
+	})
+
+	t.Run("malformed and duplicate entries", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		labels := fs.StringMap("label", nil, "Labels").UniqueKeys().Value()
+
+		err := fs.Parse([]string{"--label=env"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid map entry "env": expected key=value`)
+
+		err = fs.Parse([]string{"--label=env=a", "--label=env=b"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `duplicate key "env"`)
+
+		require.NoError(t, fs.Parse([]string{"--label=env=a", "--label=team=b"}))
+		assert.Len(t, *labels, 2)
+	})
+
+	t.Run("last value wins without UniqueKeys", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		labels := fs.StringMap("label", nil, "Labels").Value()
+		require.NoError(t, fs.Parse([]string{"--label=env=a", "--label=env=b"}))
+		assert.Equal(t, map[string]string{"env": "b"}, *labels)
+	})
+
+	t.Run("help renders sorted defaults", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.StringMap("label", map[string]string{"team": "core", "env": "dev"}, "Labels")
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "--label LABEL...")
+		assert.Contains(t, err.Error(), "(default: env=dev,team=core)")
+	})
+
+	t.Run("dynamic group", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		http := fs.DynamicGroup("http")
+		headers := http.StringMap("header", nil, "Headers").Separator(":").Delimiter("")
+		ports := tinyflags.DynamicMapVar(http, "ports", map[string]int{"http": 80}, "Ports", identity, nil, strconv.Atoi, strconv.Itoa)
+
+		require.NoError(t, fs.Parse([]string{
+			"--http.a.header=X-A: 1",
+			"--http.a.header=X-B: 2",
+			"--http.b.ports=https=443",
+		}))
+
+		got, ok := headers.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, map[string]string{"X-A": "1", "X-B": "2"}, got)
+
+		p, _ := ports.Get("a")
+		assert.Equal(t, map[string]int{"http": 80}, p)
+		p, _ = ports.Get("b")
+		assert.Equal(t, map[string]int{"https": 443}, p)
+	})
+}
@@ -0,0 +1,162 @@
+package tinyflags_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNetipFlags verifies netip and CIDR scalar, slice and dynamic flags.
+func TestNetipFlags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scalars", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		addr := fs.Addr("addr", netip.Addr{}, "Address").Value()
+		listen := fs.AddrPort("listen", netip.MustParseAddrPort("0.0.0.0:80"), "Listen").Value()
+		prefix := fs.Prefix("prefix", netip.Prefix{}, "Prefix").Value()
+		ipnet := fs.IPNet("net", nil, "Network").Value()
+
+		require.NoError(t, fs.Parse([]string{
+			"--addr", "fe80::1",
+			"--listen", "[::1]:8443",
+			"--prefix", "10.1.2.3/8",
+			"--net", "10.1.2.3/8",
+		}))
+		assert.Equal(t, netip.MustParseAddr("fe80::1"), *addr)
+		assert.Equal(t, netip.MustParseAddrPort("[::1]:8443"), *listen)
+		assert.Equal(t, "10.1.2.3/8", prefix.String())
+		assert.Equal(t, "10.0.0.0/8", (*ipnet).String())
+	})
+
+	t.Run("slices", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		allow := fs.PrefixSlice("allow", nil, "Allow-list").Value()
+		nets := fs.IPNetSlice("net", nil, "Networks").Value()
+
+		require.NoError(t, fs.Parse([]string{"--allow", "10.0.0.0/8,fd00::/8", "--net", "192.168.0.0/16"}))
+		assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")}, *allow)
+		require.Len(t, *nets, 1)
+		assert.Equal(t, "192.168.0.0/16", (*nets)[0].String())
+	})
+
+	t.Run("strict parsing", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			args []string
+			want string
+		}{
+			{name: "ip", args: []string{"--ip", "garbage"}, want: `invalid IP address "garbage"`},
+			{name: "addr", args: []string{"--addr", "10.0.0.256"}, want: `invalid IP address "10.0.0.256"`},
+			{name: "addrport", args: []string{"--listen", "10.0.0.1"}, want: `invalid address and port "10.0.0.1"`},
+			{name: "prefix", args: []string{"--prefix", "10.0.0.0"}, want: `invalid CIDR prefix "10.0.0.0"`},
+			{name: "ipnet", args: []string{"--net", "10.0.0.0/33"}, want: `invalid CIDR prefix "10.0.0.0/33"`},
+			{name: "ip slice", args: []string{"--ips", "10.0.0.1,nope"}, want: `invalid IP address "nope"`},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+				fs.IP("ip", nil, "IP")
+				fs.IPSlice("ips", nil, "IPs")
+				fs.Addr("addr", netip.Addr{}, "Address")
+				fs.AddrPort("listen", netip.AddrPort{}, "Listen")
+				fs.Prefix("prefix", netip.Prefix{}, "Prefix")
+				fs.IPNet("net", nil, "Network")
+
+				err := fs.Parse(tt.args)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.want)
+			})
+		}
+	})
+
+	t.Run("family validators", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.Addr("v4", netip.Addr{}, "IPv4").Validate(tinyflags.IPv4Only)
+		fs.PrefixSlice("v6", nil, "IPv6").Validate(tinyflags.IPv6Only)
+		fs.IP("ip", nil, "IP").Validate(tinyflags.IPv4Only)
+		fs.IPNet("net", nil, "Network").Validate(tinyflags.IPv6Only)
+
+		require.NoError(t, fs.Parse([]string{"--v4=10.0.0.1", "--v6=fd00::/8", "--ip=10.0.0.1", "--net=fd00::/8"}))
+
+		err := fs.Parse([]string{"--v4=::1"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be an IPv4 address")
+
+		err = fs.Parse([]string{"--v6=10.0.0.0/8"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be an IPv6 address")
+
+		err = fs.Parse([]string{"--net=10.0.0.0/8"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be an IPv6 address")
+	})
+
+	t.Run("dynamic group", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		svc := fs.DynamicGroup("svc")
+		listen := svc.AddrPort("listen", netip.MustParseAddrPort("127.0.0.1:80"), "Listen")
+		allow := svc.PrefixSlice("allow", nil, "Allow-list").Validate(tinyflags.IPv4Only)
+		ip := svc.IP("ip", nil, "IP")
+
+		require.NoError(t, fs.Parse([]string{"--svc.a.listen=[::]:443", "--svc.a.allow=10.0.0.0/8,192.168.0.0/16", "--svc.b.ip=10.0.0.1"}))
+		assert.Equal(t, netip.MustParseAddrPort("[::]:443"), listen.MustGet("a"))
+		got, ok := listen.Get("b")
+		assert.False(t, ok)
+		assert.Equal(t, netip.MustParseAddrPort("127.0.0.1:80"), got)
+		assert.Len(t, allow.MustGet("a"), 2)
+		assert.Equal(t, net.ParseIP("10.0.0.1"), ip.MustGet("b"))
+
+		err := fs.Parse([]string{"--svc.a.allow=fd00::/8"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be an IPv4 address")
+	})
+
+	t.Run("help defaults", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.AddrPort("listen", netip.MustParseAddrPort("0.0.0.0:80"), "Listen")
+		fs.Addr("addr", netip.Addr{}, "Address")
+
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "(default: 0.0.0.0:80)")
+		assert.NotContains(t, err.Error(), "invalid IP")
+	})
+
+	t.Run("bind and positionals", func(t *testing.T) {
+		t.Parallel()
+
+		type config struct {
+			Bind  netip.Addr     `flag:"bind" default:"127.0.0.1"`
+			Allow []netip.Prefix `flag:"allow"`
+		}
+		var cfg config
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		require.NoError(t, tinyflags.Bind(fs, &cfg))
+		target := tinyflags.Positional[netip.AddrPort](fs, "target", "Target")
+
+		require.NoError(t, fs.Parse([]string{"--allow=10.0.0.0/8", "10.0.0.1:22"}))
+		assert.Equal(t, netip.MustParseAddr("127.0.0.1"), cfg.Bind)
+		assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, cfg.Allow)
+		assert.Equal(t, netip.MustParseAddrPort("10.0.0.1:22"), *target.Value())
+	})
+}
@@ -0,0 +1,135 @@
+package tinyflags_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPatternFlags verifies regexp, glob and template flags.
+func TestPatternFlags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("compiled while parsing", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		filter := fs.Regexp("filter", nil, "Filter").Value()
+		include := fs.Glob("include", "*", "Include").Value()
+		format := fs.Template("format", nil, "Format").Value()
+
+		require.NoError(t, fs.Parse([]string{"--filter", "^api-[0-9]+$", "--include", "*.go", "--format", "{{.Name}}!"}))
+		assert.True(t, (*filter).MatchString("api-42"))
+		assert.Equal(t, "*.go", *include)
+
+		var out bytes.Buffer
+		require.NoError(t, (*format).Execute(&out, map[string]string{"Name": "web"}))
+		assert.Equal(t, "web!", out.String())
+		assert.Equal(t, "format", (*format).Name())
+	})
+
+	t.Run("invalid patterns", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			args []string
+			want string
+		}{
+			{name: "regexp", args: []string{"--filter", "a(b"}, want: "invalid value for flag --filter: error parsing regexp"},
+			{name: "glob", args: []string{"--include", "[a-"}, want: `invalid value for flag --include: invalid glob pattern "[a-"`},
+			{name: "template", args: []string{"--format", "{{.Name"}, want: "invalid value for flag --format: template: format:1"},
+			{name: "regexp slice", args: []string{"--filters", "ok|fine", "--filters", "*bad"}, want: "invalid value for flag --filters"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+				fs.Regexp("filter", nil, "Filter")
+				fs.RegexpSlice("filters", nil, "Filters")
+				fs.Glob("include", "", "Include")
+				fs.Template("format", nil, "Format")
+
+				err := fs.Parse(tt.args)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.want)
+			})
+		}
+	})
+
+	t.Run("help shows source patterns", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.Regexp("filter", regexp.MustCompile(`^v\d+$`), "Filter")
+		fs.GlobSlice("include", []string{"*.go", "*.md"}, "Include")
+		fs.Template("format", template.Must(template.New("format").Parse("{{.Name}}: {{.Status}}")), "Format")
+
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), `(default: ^v\d+$)`)
+		assert.Contains(t, err.Error(), "(default: *.go,*.md)")
+		assert.Contains(t, err.Error(), "(default: {{.Name}}: {{.Status}})")
+	})
+
+	t.Run("dump keeps template source", func(t *testing.T) {
+		t.Parallel()
+
+		src := `{{define "row"}}{{.}}{{end}}{{/* rows */}}{{- range . }} {{template "row" .}} {{- end}}`
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		format := fs.Template("format", nil, "Format").Value()
+		require.NoError(t, fs.Parse([]string{"--format", src}))
+
+		var out bytes.Buffer
+		require.NoError(t, fs.Dump(&out, tinyflags.DumpJSON))
+		var dumped map[string]string
+		require.NoError(t, json.Unmarshal(out.Bytes(), &dumped))
+		assert.Equal(t, src, dumped["format"])
+
+		require.NoError(t, fs.Parse([]string{"--format", dumped["format"]}))
+		out.Reset()
+		require.NoError(t, (*format).Execute(&out, []string{"a", "b"}))
+		assert.Equal(t, " a b", out.String())
+	})
+
+	t.Run("dynamic group", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		route := fs.DynamicGroup("route")
+		match := route.Regexp("match", nil, "Match")
+		files := route.GlobSlice("files", nil, "Files")
+
+		require.NoError(t, fs.Parse([]string{"--route.api.match=^/api/", "--route.api.files=*.json,*.yaml"}))
+		assert.True(t, match.MustGet("api").MatchString("/api/users"))
+		assert.Equal(t, []string{"*.json", "*.yaml"}, files.MustGet("api"))
+
+		err := fs.Parse([]string{"--route.web.match=("})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error parsing regexp")
+	})
+
+	t.Run("bind and positionals", func(t *testing.T) {
+		t.Parallel()
+
+		type config struct {
+			Filter *regexp.Regexp `flag:"filter" default:"^a"`
+		}
+		var cfg config
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		require.NoError(t, tinyflags.Bind(fs, &cfg))
+		pattern := tinyflags.Positional[*regexp.Regexp](fs, "pattern", "Pattern")
+
+		require.NoError(t, fs.Parse([]string{"b+"}))
+		assert.Equal(t, "^a", cfg.Filter.String())
+		assert.Equal(t, "b+", (*pattern.Value()).String())
+	})
+}
@@ -0,0 +1,185 @@
+package tinyflags_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPositionalArguments verifies typed positional arguments with arity and validation.
+func TestPositionalArguments(t *testing.T) {
+	t.Parallel()
+
+	t.Run("required, optional and variadic", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("deploy", tinyflags.ContinueOnError)
+		env := tinyflags.Positional[string](fs, "env", "Target environment")
+		replicas := tinyflags.OptionalPositional(fs, "replicas", 1, "Replica count")
+		targets := tinyflags.VariadicPositional[string](fs, "targets", "Hosts to deploy to", 0, -1)
+
+		require.NoError(t, fs.Parse([]string{"prod", "3", "a", "b"}))
+		assert.Equal(t, "prod", *env.Value())
+		assert.Equal(t, 3, *replicas.Value())
+		assert.True(t, replicas.Changed())
+		assert.Equal(t, []string{"a", "b"}, *targets.Value())
+		assert.Equal(t, []string{"prod", "3", "a", "b"}, fs.Args())
+
+		require.NoError(t, fs.Parse([]string{"staging"}))
+		assert.Equal(t, "staging", *env.Value())
+		assert.Equal(t, 1, *replicas.Value())
+		assert.False(t, replicas.Changed())
+		assert.Empty(t, *targets.Value())
+	})
+
+	t.Run("missing required argument", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("deploy", tinyflags.ContinueOnError)
+		tinyflags.Positional[string](fs, "env", "Target environment")
+		err := fs.Parse(nil)
+		assert.EqualError(t, err, "missing required argument <env>")
+	})
+
+	t.Run("variadic bounds", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("copy", tinyflags.ContinueOnError)
+		src := tinyflags.VariadicPositional[string](fs, "src", "Sources", 1, 2)
+		dst := tinyflags.Positional[string](fs, "dst", "Destination")
+
+		require.NoError(t, fs.Parse([]string{"a", "b", "out"}))
+		assert.Equal(t, []string{"a", "b"}, *src.Value())
+		assert.Equal(t, "out", *dst.Value())
+
+		err := fs.Parse([]string{"out"})
+		assert.EqualError(t, err, "argument <src...> requires at least 1 value, got 0")
+
+		err = fs.Parse([]string{"a", "b", "c", "out"})
+		assert.EqualError(t, err, "too many arguments: expected at most 3, got 4")
+	})
+
+	t.Run("typed parsing and choices", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("wait", tinyflags.ContinueOnError)
+		env := tinyflags.Positional[string](fs, "env", "Target environment").Choices("dev", "prod")
+		timeout := tinyflags.Positional[time.Duration](fs, "timeout", "Timeout")
+
+		require.NoError(t, fs.Parse([]string{"dev", "5s"}))
+		assert.Equal(t, "dev", *env.Value())
+		assert.Equal(t, 5*time.Second, *timeout.Value())
+
+		err := fs.Parse([]string{"qa", "5s"})
+		require.Error(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), "invalid value for argument <env>: "))
+
+		err = fs.Parse([]string{"dev", "soon"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid value for argument <timeout>")
+	})
+
+	t.Run("validate and finalize", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("tag", tinyflags.ContinueOnError)
+		names := tinyflags.VariadicPositional[string](fs, "names", "Tag names", 1, -1).
+			Validate(func(s string) error {
+				if s == "" {
+					return errors.New("empty tag")
+				}
+				return nil
+			}).
+			Finalize(strings.ToUpper)
+
+		require.NoError(t, fs.Parse([]string{"a", "b"}))
+		assert.Equal(t, []string{"A", "B"}, *names.Value())
+
+		assert.EqualError(t, fs.Parse([]string{"a", ""}), "invalid value for argument <names...>: empty tag")
+	})
+
+	t.Run("custom type", func(t *testing.T) {
+		t.Parallel()
+
+		type level int
+		fs := tinyflags.NewFlagSet("log", tinyflags.ContinueOnError)
+		lvl := tinyflags.CustomPositional(fs, "level", "Log level",
+			func(s string) (level, error) { return level(len(s)), nil },
+			nil,
+		)
+		require.NoError(t, fs.Parse([]string{"warn"}))
+		assert.Equal(t, level(4), *lvl.Value())
+	})
+
+	t.Run("invalid declarations panic", func(t *testing.T) {
+		t.Parallel()
+
+		assert.PanicsWithValue(t, `tinyflags: required positional "dst" cannot follow optional "src"`, func() {
+			fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+			tinyflags.OptionalPositional(fs, "src", "", "Source")
+			tinyflags.Positional[string](fs, "dst", "Destination")
+		})
+		assert.PanicsWithValue(t, `tinyflags: positional "extra" cannot follow variadic "files"`, func() {
+			fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+			tinyflags.VariadicPositional[string](fs, "files", "Files", 0, -1)
+			tinyflags.OptionalPositional(fs, "extra", "", "Extra")
+		})
+		assert.Panics(t, func() {
+			fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+			tinyflags.Positional[struct{}](fs, "thing", "Thing")
+		})
+	})
+
+	t.Run("help output", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("deploy", tinyflags.ContinueOnError)
+		fs.Bool("dry-run", false, "Only print actions")
+		tinyflags.Positional[string](fs, "env", "Target environment").Choices("dev", "prod")
+		tinyflags.OptionalPositional(fs, "replicas", 1, "Replica count")
+		tinyflags.VariadicPositional[string](fs, "targets", "Hosts", 0, -1)
+
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		out := err.Error()
+		assert.Contains(t, out, "Usage: deploy [flags] <env> [replicas] [targets...]\n")
+		assert.Contains(t, out, "Arguments:\n")
+		assert.Contains(t, out, "<env>         Target environment (allowed: dev, prod)\n")
+		assert.Contains(t, out, "[replicas]    Replica count (default: 1)\n")
+		assert.Contains(t, out, "[targets...]  Hosts\n")
+	})
+}
+
+// TestCommandPositionalArguments verifies positional arguments on commands and Run bindings.
+func TestCommandPositionalArguments(t *testing.T) {
+	t.Parallel()
+
+	root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+	deploy := root.Command("deploy", "Deploy the app")
+	env := tinyflags.Positional[string](deploy.FlagSet, "env", "Target environment")
+	targets := tinyflags.VariadicPositional[string](deploy.FlagSet, "targets", "Hosts", 0, -1)
+
+	var gotEnv string
+	var gotTargets []string
+	deploy.Run(func(_ context.Context, e string, ts []string) error {
+		gotEnv, gotTargets = e, ts
+		return nil
+	}, env.Value(), targets.Value())
+
+	runner, err := root.ParseRunner([]string{"deploy", "prod", "web1", "web2"})
+	require.NoError(t, err)
+	require.NoError(t, runner.Run(context.Background()))
+	assert.Equal(t, "prod", gotEnv)
+	assert.Equal(t, []string{"web1", "web2"}, gotTargets)
+
+	err = root.Parse([]string{"deploy", "--help"})
+	require.True(t, tinyflags.IsHelpRequested(err))
+	assert.Contains(t, err.Error(), "Usage: app deploy [flags] <env> [targets...]")
+	assert.Contains(t, err.Error(), "Arguments:")
+}
@@ -0,0 +1,129 @@
+package tinyflags_test
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQuotedSlice verifies CSV-style splitting of quoted slice and map flags.
+func TestQuotedSlice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("quotes and escapes keep delimiters", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		headers := fs.StringSlice("header", nil, "Headers").Quoted().Value()
+
+		require.NoError(t, fs.Parse([]string{"--header", `"a,b",c`, "--header", `x\,y,"say \"hi\""`}))
+		assert.Equal(t, []string{"a,b", "c", "x,y", `say "hi"`}, *headers)
+	})
+
+	t.Run("trimming only applies outside quotes", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		items := fs.StringSlice("item", nil, "Items").Quoted().TrimSpace().Value()
+
+		require.NoError(t, fs.Parse([]string{"--item", ` " a " , b ,c`}))
+		assert.Equal(t, []string{" a ", "b", "c"}, *items)
+	})
+
+	t.Run("plain splitting is unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		items := fs.StringSlice("item", nil, "Items").Value()
+
+		require.NoError(t, fs.Parse([]string{"--item", `"a,b"`}))
+		assert.Equal(t, []string{`"a`, `b"`}, *items)
+	})
+
+	t.Run("malformed input", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.StringSlice("item", nil, "Items").Quoted()
+
+		err := fs.Parse([]string{"--item", `"a,b`})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid value for flag --item")
+		assert.Contains(t, err.Error(), "unterminated quote")
+
+		err = fs.Parse([]string{"--item", `a\`})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "trailing backslash")
+	})
+
+	t.Run("typed items and maps", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		ports := fs.IntSlice("port", nil, "Ports").Quoted().Value()
+		labels := fs.StringMap("label", nil, "Labels").Quoted().Value()
+
+		require.NoError(t, fs.Parse([]string{"--port", `"80",443`, "--label", `"team=a,b",env=dev`}))
+		assert.Equal(t, []int{80, 443}, *ports)
+		assert.Equal(t, map[string]string{"team": "a,b", "env": "dev"}, *labels)
+	})
+
+	t.Run("dynamic group", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		http := fs.DynamicGroup("http")
+		headers := http.StringSlice("header", nil, "Headers").Quoted()
+
+		require.NoError(t, fs.Parse([]string{"--http.a.header", `"X-A: 1,2",X-B: 3`}))
+		assert.Equal(t, []string{"X-A: 1,2", "X-B: 3"}, headers.MustGet("a"))
+	})
+
+	t.Run("help quotes defaults", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.StringSlice("header", []string{"a,b", "c"}, "Headers").Quoted()
+
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), `(default: "a,b",c)`)
+	})
+
+	t.Run("env dump round trip", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.EnvPrefix("APP")
+		fs.SetGetEnvFn(func(string) string { return "" })
+		fs.StringSlice("header", nil, "Headers").Quoted()
+
+		require.NoError(t, fs.Parse([]string{"--header", `"a,b"`, "--header", `say \"hi\"`, "--header", "c"}))
+		var out bytes.Buffer
+		require.NoError(t, fs.Dump(&out, tinyflags.DumpDotenv))
+		key, val, ok := strings.Cut(strings.TrimSpace(out.String()), "=")
+		require.True(t, ok)
+		assert.Equal(t, "APP_HEADER", key)
+		raw, err := strconv.Unquote(val)
+		require.NoError(t, err)
+		assert.Equal(t, `"a,b","say \"hi\"",c`, raw)
+
+		loaded := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		loaded.EnvPrefix("APP")
+		loaded.SetGetEnvFn(func(k string) string {
+			if k == key {
+				return raw
+			}
+			return ""
+		})
+		headers := loaded.StringSlice("header", nil, "Headers").Quoted().Value()
+
+		require.NoError(t, loaded.Parse(nil))
+		assert.Equal(t, []string{"a,b", `say "hi"`, "c"}, *headers)
+	})
+}
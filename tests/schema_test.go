@@ -0,0 +1,214 @@
+package tinyflags_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSchemaApp builds a command tree covering the schema model.
+func newSchemaApp() *tinyflags.Command {
+	root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+	root.Version("1.0.0")
+	root.EnvPrefix("APP")
+	root.Globals().Counter("verbose", 0, "Verbosity").Short("v")
+	root.String("config", "", "Config file")
+	root.ConfigFileFlag("config")
+
+	serve := root.Command("serve", "Start the server")
+	serve.EnvPrefix("APP")
+	serve.Globals().Bool("dry-run", false, "Dry run").Strict()
+	serve.Int("port", 8080, "Listen port").Short("p").Section("Network")
+	serve.StringSlice("tag", []string{"a", "b"}, "Tags").Requires("port")
+	serve.Bytes("max-body", 10<<20, "Max body")
+	serve.StringMap("label", nil, "Labels")
+	serve.String("mode", "fast", "Mode").Choices("fast", "slow").Deprecated("use --profile")
+	serve.String("token", "", "Token").Required().DisableConfig().Hidden()
+	serve.String("cert", "", "TLS cert").OneOfGroup("source")
+	serve.String("user", "", "User").AllOrNone("auth")
+	serve.String("pass", "", "Password").AllOrNone("auth").DisableEnv()
+	serve.GetOneOfGroup("source").Title("TLS source").Required().AddGroup(serve.GetAllOrNoneGroup("auth"))
+
+	http := serve.DynamicGroup("http")
+	http.Title("HTTP targets")
+	http.Duration("timeout", 0, "Timeout")
+	http.StringSlice("header", nil, "Headers")
+	http.BytesSlice("limit", nil, "Limits")
+
+	root.AddCompletionCommand()
+	return root
+}
+
+// flagByName finds a schema flag.
+func flagByName(t *testing.T, flags []tinyflags.SchemaFlag, name string) tinyflags.SchemaFlag {
+	t.Helper()
+	for _, fl := range flags {
+		if fl.Name == name {
+			return fl
+		}
+	}
+	require.Failf(t, "flag not found", "%s", name)
+	return tinyflags.SchemaFlag{}
+}
+
+// TestSchema verifies the structured flag and command model.
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	t.Run("command tree", func(t *testing.T) {
+		t.Parallel()
+
+		s := newSchemaApp().Schema()
+		assert.Equal(t, tinyflags.SchemaVersion, s.Version)
+
+		root := s.Command
+		assert.Equal(t, "app", root.Path)
+		assert.Equal(t, "1.0.0", root.Version)
+		assert.Equal(t, "APP", root.EnvPrefix)
+		assert.Equal(t, tinyflags.SchemaFlag{
+			Name: "verbose", Short: "v", Usage: "Verbosity", Type: "counter", Default: "0", EnvKey: "APP_VERBOSE",
+		}, flagByName(t, root.Flags, "verbose"))
+		assert.True(t, flagByName(t, root.Flags, "config").DisableConfig)
+		assert.True(t, flagByName(t, root.Flags, "help").DisableEnv)
+		require.Len(t, root.Commands, 2)
+		assert.True(t, root.Commands[1].Hidden)
+
+		serve := root.Commands[0]
+		assert.Equal(t, "app serve", serve.Path)
+		assert.Equal(t, "Start the server", serve.Summary)
+		assert.Equal(t, []tinyflags.SchemaFlag{{
+			Name: "dry-run", Usage: "Dry run", Type: "bool", Default: "false", Placeholder: "<true|false>", Strict: true,
+		}}, serve.Globals)
+
+		assert.Equal(t, tinyflags.SchemaFlag{
+			Name: "port", Short: "p", Usage: "Listen port", Type: "int", Default: "8080", Placeholder: "PORT", EnvKey: "APP_PORT", Section: "Network",
+		}, flagByName(t, serve.Flags, "port"))
+		tag := flagByName(t, serve.Flags, "tag")
+		assert.Equal(t, "[]string", tag.Type)
+		assert.Equal(t, "a,b", tag.Default)
+		assert.True(t, tag.Slice)
+		assert.Equal(t, []string{"port"}, tag.Requires)
+		assert.Equal(t, "bytes", flagByName(t, serve.Flags, "max-body").Format)
+		mode := flagByName(t, serve.Flags, "mode")
+		assert.Equal(t, []string{"fast", "slow"}, mode.Allowed)
+		assert.Equal(t, "use --profile", mode.Deprecated)
+		token := flagByName(t, serve.Flags, "token")
+		assert.True(t, token.Required)
+		assert.True(t, token.Hidden)
+		assert.True(t, token.DisableConfig)
+		assert.Equal(t, []string{"source"}, flagByName(t, serve.Flags, "cert").OneOf)
+		pass := flagByName(t, serve.Flags, "pass")
+		assert.Equal(t, "auth", pass.AllOrNone)
+		assert.True(t, pass.DisableEnv)
+		assert.Empty(t, pass.EnvKey)
+
+		assert.Equal(t, []tinyflags.SchemaOneOfGroup{{
+			Name: "source", Title: "TLS source", Required: true, Flags: []string{"cert"}, Groups: []string{"auth"},
+		}}, serve.OneOf)
+		assert.Equal(t, []tinyflags.SchemaAllOrNoneGroup{{Name: "auth", Flags: []string{"user", "pass"}}}, serve.AllOrNone)
+
+		require.Len(t, serve.Groups, 1)
+		group := serve.Groups[0]
+		assert.Equal(t, "http", group.Name)
+		assert.Equal(t, "HTTP targets", group.Title)
+		assert.Equal(t, []tinyflags.SchemaFlag{
+			{Name: "timeout", Usage: "Timeout", Type: "time.Duration", Default: "0s", Placeholder: "TIMEOUT", EnvKey: "APP_HTTP_{ID}_TIMEOUT"},
+			{Name: "header", Usage: "Headers", Type: "[]string", Placeholder: "HEADER...", EnvKey: "APP_HTTP_{ID}_HEADER", Slice: true},
+			{Name: "limit", Usage: "Limits", Type: "[]uint64", Placeholder: "LIMIT...", EnvKey: "APP_HTTP_{ID}_LIMIT", Slice: true, Format: "bytes"},
+		}, group.Fields)
+	})
+
+	t.Run("json encoding", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("tool", tinyflags.ContinueOnError)
+		fs.DisableHelp()
+		fs.Int("port", 80, "Port")
+
+		data, err := json.Marshal(fs.Schema())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"version": 1,
+			"command": {
+				"name": "tool",
+				"path": "tool",
+				"flags": [{"name": "port", "usage": "Port", "type": "int", "default": "80", "placeholder": "PORT"}]
+			}
+		}`, string(data))
+
+		var back tinyflags.Schema
+		require.NoError(t, json.Unmarshal(data, &back))
+		assert.Equal(t, fs.Schema(), back)
+	})
+}
+
+// TestWriteConfigSchema verifies the config file JSON Schema document.
+func TestWriteConfigSchema(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, newSchemaApp().WriteConfigSchema(&buf))
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", doc["$schema"])
+	assert.Equal(t, "app configuration", doc["title"])
+	assert.Equal(t, false, doc["additionalProperties"])
+
+	props := doc["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "integer", "description": "Verbosity", "default": float64(0)}, props["verbose"])
+	assert.NotContains(t, props, "config")
+	assert.NotContains(t, props, "help")
+	assert.NotContains(t, props, "version")
+	assert.Contains(t, props, "completion")
+
+	serve := props["serve"].(map[string]any)
+	assert.Equal(t, "Start the server", serve["description"])
+	assert.Equal(t, false, serve["additionalProperties"])
+	sp := serve["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "boolean", "description": "Dry run", "default": false}, sp["dry-run"])
+	assert.Equal(t, map[string]any{"type": "integer", "description": "Listen port", "default": float64(8080)}, sp["port"])
+	assert.Equal(t, map[string]any{
+		"anyOf": []any{
+			map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			map[string]any{"type": "string"},
+		},
+		"description": "Tags",
+		"default":     "a,b",
+	}, sp["tag"])
+	assert.Equal(t, map[string]any{
+		"anyOf":       []any{map[string]any{"type": "integer"}, map[string]any{"type": "string"}},
+		"description": "Max body",
+		"default":     "10MiB",
+	}, sp["max-body"])
+	assert.Equal(t, map[string]any{
+		"anyOf": []any{
+			map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}},
+			map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			map[string]any{"type": "string"},
+		},
+		"description": "Labels",
+	}, sp["label"])
+	assert.Equal(t, map[string]any{"enum": []any{"fast", "slow"}, "description": "Mode", "default": "fast", "deprecated": true}, sp["mode"])
+	assert.NotContains(t, sp, "token")
+
+	http := sp["http"].(map[string]any)
+	assert.Equal(t, "object", http["type"])
+	assert.Equal(t, "HTTP targets", http["description"])
+	instance := http["additionalProperties"].(map[string]any)
+	assert.Equal(t, false, instance["additionalProperties"])
+	fields := instance["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "string", "description": "Timeout", "default": "0s"}, fields["timeout"])
+	assert.Contains(t, fields, "header")
+	assert.Equal(t, map[string]any{
+		"anyOf": []any{
+			map[string]any{"type": "array", "items": map[string]any{"anyOf": []any{map[string]any{"type": "integer"}, map[string]any{"type": "string"}}}},
+			map[string]any{"type": "string"},
+		},
+		"description": "Limits",
+	}, fields["limit"])
+}
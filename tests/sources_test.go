@@ -0,0 +1,122 @@
+package tinyflags_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSources verifies provenance for static flags across all input layers.
+func TestSources(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, "{\n  \"port\": 9000,\n  \"db\": {\n    \"password\": \"hunter2\"\n  }\n}\n")
+
+	newFlagSet := func() (*tinyflags.FlagSet, func() tinyflags.Source) {
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.EnvPrefix("APP")
+		fs.SetGetEnvFn(func(key string) string {
+			if key == "APP_TIMEOUT" {
+				return "5s"
+			}
+			return ""
+		})
+		fs.ConfigFile(path)
+		host := fs.String("host", "localhost", "Host")
+		fs.Duration("timeout", 0, "Timeout")
+		fs.Int("port", 80, "Port")
+		fs.String("db.password", "", "Password").OverriddenValueMaskFn(tinyflags.MaskFirstLast)
+		fs.String("name", " App ", "Name").Finalize(strings.TrimSpace).FinalizeDefaultValue()
+		fs.String("level", "info", "Level")
+		return fs, host.Source
+	}
+
+	t.Run("sources map", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := newFlagSet()
+		require.NoError(t, fs.Parse([]string{"--host", "example.com"}))
+
+		sources := fs.Sources()
+		assert.Equal(t, tinyflags.SourceCLI, sources["host"].Kind)
+		assert.Equal(t, "example.com", sources["host"].Value)
+		assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceEnv, Key: "APP_TIMEOUT", Value: sources["timeout"].Value}, sources["timeout"])
+		assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceConfig, Key: "port", File: path, Line: 2, Value: 9000}, sources["port"])
+		assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceConfig, Key: "db.password", File: path, Line: 4, Value: "h*****2"}, sources["db.password"])
+		assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceFinalizer, Value: "App"}, sources["name"])
+		assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceDefault, Value: "info"}, sources["level"])
+		assert.NotContains(t, sources, "help")
+	})
+
+	t.Run("flag handle", func(t *testing.T) {
+		t.Parallel()
+
+		fs, hostSource := newFlagSet()
+		require.NoError(t, fs.Parse([]string{"--host", "example.com"}))
+		assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceCLI, Value: "example.com"}, hostSource())
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceDefault, Value: "localhost"}, hostSource())
+	})
+
+	t.Run("string form", func(t *testing.T) {
+		t.Parallel()
+
+		fs, _ := newFlagSet()
+		require.NoError(t, fs.Parse(nil))
+		sources := fs.Sources()
+		assert.Equal(t, "env APP_TIMEOUT", sources["timeout"].String())
+		assert.Equal(t, "config "+path+":2", sources["port"].String())
+		assert.Equal(t, "default", sources["host"].String())
+		assert.Equal(t, "cli", tinyflags.Source{Kind: tinyflags.SourceCLI}.String())
+	})
+}
+
+// TestSourcesDynamic verifies provenance for dynamic instance values.
+func TestSourcesDynamic(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, `{"http": {"gamma": {"port": 8083}}}`)
+
+	fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+	fs.EnvPrefix("APP")
+	fs.ConfigFile(path)
+	fs.SetGetEnvFn(func(string) string { return "" })
+	http := fs.DynamicGroup("http")
+	port := http.Int("port", 80, "Port")
+	http.String("token", "", "Token").OverriddenValueMaskFn(func(any) any { return "***" })
+
+	require.NoError(t, fs.Parse([]string{"--http.alpha.port=8081", "--http.beta.token=secret"}))
+
+	src, ok := port.Source("alpha")
+	assert.True(t, ok)
+	assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceCLI, Value: 8081}, src)
+
+	src, ok = port.Source("beta")
+	assert.False(t, ok)
+	assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceDefault, Value: 80}, src)
+
+	sources := fs.Sources()
+	assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceConfig, Key: "http.gamma.port", File: path, Line: 1, Value: 8083}, sources["http.gamma.port"])
+	assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceCLI, Value: "***"}, sources["http.beta.token"])
+	assert.Equal(t, tinyflags.SourceDefault, sources["http.alpha.token"].Kind)
+	assert.Len(t, sources, 6)
+}
+
+// TestCommandSources verifies sources merge local and inherited flag sets.
+func TestCommandSources(t *testing.T) {
+	t.Parallel()
+
+	root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+	root.Globals().Bool("verbose", false, "Verbose")
+	serve := root.Command("serve", "Serve")
+	serve.String("addr", ":8080", "Address")
+
+	require.NoError(t, root.Parse([]string{"serve", "--verbose"}))
+	sources := root.Sources()
+	assert.Equal(t, tinyflags.SourceCLI, sources["verbose"].Kind)
+	assert.Equal(t, tinyflags.Source{Kind: tinyflags.SourceDefault, Value: ":8080"}, sources["addr"])
+}
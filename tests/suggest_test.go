@@ -0,0 +1,200 @@
+package tinyflags_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnknownFlagSuggestions verifies "did you mean" hints for unknown flags.
+func TestUnknownFlagSuggestions(t *testing.T) {
+	t.Parallel()
+
+	newFlagSet := func() *tinyflags.FlagSet {
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.Bool("verbose", false, "Verbose")
+		fs.String("color", "", "Color")
+		fs.String("colour", "", "Colour")
+		fs.Int("port", 0, "Port").Short("p")
+		fs.String("token", "", "Token").Hidden()
+		http := fs.DynamicGroup("http")
+		http.Int("port", 0, "Port")
+		http.String("secret", "", "Secret").Hidden()
+		return fs
+	}
+
+	t.Run("long name", func(t *testing.T) {
+		t.Parallel()
+
+		err := newFlagSet().Parse([]string{"--verbsoe"})
+		require.Error(t, err)
+		assert.EqualError(t, err, "unknown flag --verbsoe (did you mean --verbose?)")
+		assert.True(t, tinyflags.IsUnknownFlag(err))
+
+		var unknown *tinyflags.UnknownFlagError
+		require.True(t, errors.As(err, &unknown))
+		assert.Equal(t, "--verbsoe", unknown.Flag)
+		assert.Equal(t, []string{"--verbose"}, unknown.Suggestions)
+	})
+
+	t.Run("several matches closest first", func(t *testing.T) {
+		t.Parallel()
+
+		err := newFlagSet().Parse([]string{"--colr=red"})
+		assert.EqualError(t, err, "unknown flag --colr (did you mean --color or --colour?)")
+	})
+
+	t.Run("short alias", func(t *testing.T) {
+		t.Parallel()
+
+		err := newFlagSet().Parse([]string{"--p=1"})
+		assert.EqualError(t, err, "unknown flag --p (did you mean -p?)")
+	})
+
+	t.Run("hidden flags and unrelated names are not suggested", func(t *testing.T) {
+		t.Parallel()
+
+		err := newFlagSet().Parse([]string{"--tokn"})
+		assert.EqualError(t, err, "unknown flag --tokn")
+
+		err = newFlagSet().Parse([]string{"-x"})
+		assert.EqualError(t, err, "unknown flag -x")
+	})
+
+	t.Run("dynamic group and field", func(t *testing.T) {
+		t.Parallel()
+
+		err := newFlagSet().Parse([]string{"--htpp.alpha.port=80"})
+		assert.EqualError(t, err, `unknown dynamic group "htpp" in flag --htpp.alpha.port=80 (did you mean --http.alpha.port?)`)
+
+		var unknown *tinyflags.UnknownFlagError
+		require.True(t, errors.As(err, &unknown))
+		assert.Equal(t, "htpp", unknown.Group)
+
+		err = newFlagSet().Parse([]string{"--http.alpha.prot=80"})
+		assert.EqualError(t, err, `unknown dynamic field "prot" in flag --http.alpha.prot=80 (did you mean --http.alpha.port?)`)
+
+		err = newFlagSet().Parse([]string{"--http.alpha.secrt=x"})
+		assert.EqualError(t, err, `unknown dynamic field "secrt" in flag --http.alpha.secrt=x`)
+	})
+
+	t.Run("distance threshold", func(t *testing.T) {
+		t.Parallel()
+
+		fs := newFlagSet()
+		fs.SetSuggestionDistance(1)
+		err := fs.Parse([]string{"--vrebsoe"})
+		assert.EqualError(t, err, "unknown flag --vrebsoe")
+
+		fs = newFlagSet()
+		fs.SetSuggestionDistance(3)
+		err = fs.Parse([]string{"--vrebsoe"})
+		assert.EqualError(t, err, "unknown flag --vrebsoe (did you mean --verbose?)")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		fs := newFlagSet()
+		fs.DisableSuggestions()
+		err := fs.Parse([]string{"--verbsoe"})
+		assert.EqualError(t, err, "unknown flag --verbsoe")
+		assert.True(t, tinyflags.IsUnknownFlag(err))
+	})
+}
+
+// TestUnknownCommandSuggestions verifies "did you mean" hints for mistyped commands.
+func TestUnknownCommandSuggestions(t *testing.T) {
+	t.Parallel()
+
+	newApp := func() *tinyflags.Command {
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+		root.Globals().Bool("verbose", false, "Verbose")
+		serve := root.Command("serve", "Start the server")
+		serve.Int("port", 0, "Port")
+		root.Command("status", "Show status")
+		root.AddCompletionCommand()
+		return root
+	}
+
+	t.Run("mistyped command", func(t *testing.T) {
+		t.Parallel()
+
+		err := newApp().Parse([]string{"serev"})
+		require.Error(t, err)
+		assert.EqualError(t, err, `unknown command "serev" for "app" (did you mean "serve"?)`)
+		assert.True(t, tinyflags.IsUnknownCommand(err))
+
+		var unknown *tinyflags.UnknownCommandError
+		require.True(t, errors.As(err, &unknown))
+		assert.Equal(t, "app", unknown.Command)
+		assert.Equal(t, "serev", unknown.Name)
+		assert.Equal(t, []string{"serve"}, unknown.Suggestions)
+
+		help, ok := tinyflags.HelpText(err)
+		require.True(t, ok)
+		assert.Contains(t, help, "Usage: app")
+	})
+
+	t.Run("hidden commands are not suggested", func(t *testing.T) {
+		t.Parallel()
+
+		root := newApp()
+		require.NoError(t, root.Parse([]string{"completon"}))
+		assert.Equal(t, []string{"completon"}, root.Args())
+	})
+
+	t.Run("unrelated positionals are kept", func(t *testing.T) {
+		t.Parallel()
+
+		root := newApp()
+		require.NoError(t, root.Parse([]string{"file.txt", "serev"}))
+		assert.Equal(t, []string{"file.txt", "serev"}, root.Args())
+
+		root = newApp()
+		require.NoError(t, root.Parse([]string{"--", "serev"}))
+	})
+
+	t.Run("required command", func(t *testing.T) {
+		t.Parallel()
+
+		root := newApp().RequireCommand()
+		err := root.Parse([]string{"deploy"})
+		assert.EqualError(t, err, `command "app" requires a subcommand`)
+		assert.True(t, tinyflags.IsCommandRequired(err))
+
+		err = newApp().RequireCommand().Parse([]string{"serev"})
+		assert.True(t, tinyflags.IsUnknownCommand(err))
+	})
+
+	t.Run("commands with positionals keep near misses", func(t *testing.T) {
+		t.Parallel()
+
+		root := newApp()
+		file := tinyflags.Positional[string](root.FlagSet, "file", "File")
+		require.NoError(t, root.Parse([]string{"serev"}))
+		assert.Equal(t, "serev", *file.Value())
+	})
+
+	t.Run("inherited flags are suggested", func(t *testing.T) {
+		t.Parallel()
+
+		err := newApp().Parse([]string{"serve", "--verbsoe"})
+		assert.EqualError(t, err, "unknown flag --verbsoe (did you mean --verbose?)")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		root := newApp()
+		root.DisableSuggestions()
+		require.NoError(t, root.Parse([]string{"serev"}))
+		assert.Equal(t, []string{"serev"}, root.Args())
+
+		err := root.Parse([]string{"serve", "--prot=1"})
+		assert.EqualError(t, err, "unknown flag --prot")
+	})
+}
@@ -0,0 +1,237 @@
+package tinyflags_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedNow is the clock used to resolve relative times in tests.
+var fixedNow = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// TestTimeFlags verifies time layouts, relative times, locations and ranges.
+func TestTimeFlags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default layout is RFC3339", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		since := fs.Time("since", time.Time{}, "Since").Value()
+
+		require.NoError(t, fs.Parse([]string{"--since", "2024-01-02T03:04:05+01:00"}))
+		assert.True(t, since.Equal(time.Date(2024, 1, 2, 2, 4, 5, 0, time.UTC)))
+
+		err := fs.Parse([]string{"--since", "2024-01-02"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid value for flag --since: invalid time "2024-01-02"`)
+	})
+
+	t.Run("configured layouts", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			layout string
+			arg    string
+			want   time.Time
+		}{
+			{name: "date only", layout: time.DateOnly, arg: "2024-03-04", want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+			{name: "rfc1123", layout: time.RFC1123, arg: "Mon, 04 Mar 2024 10:00:00 UTC", want: time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)},
+			{name: "unix seconds", layout: tinyflags.TimeLayoutUnix, arg: "1700000000", want: time.Unix(1700000000, 0)},
+			{name: "unix millis", layout: tinyflags.TimeLayoutUnixMilli, arg: "1700000000123", want: time.UnixMilli(1700000000123)},
+			{name: "fallback layout", layout: time.DateOnly, arg: "2024-03-04T05:06:07Z", want: time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+				fs.SetTimeLayouts(tt.layout, time.RFC3339)
+				at := fs.Time("at", time.Time{}, "At").Value()
+
+				require.NoError(t, fs.Parse([]string{"--at", tt.arg}))
+				assert.True(t, at.Equal(tt.want), "got %s", at)
+			})
+		}
+	})
+
+	t.Run("location for zoneless layouts", func(t *testing.T) {
+		t.Parallel()
+
+		zurich, err := time.LoadLocation("Europe/Zurich")
+		require.NoError(t, err)
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.SetTimeLayouts(time.DateTime)
+		fs.SetTimeLocation(zurich)
+		at := fs.Time("at", time.Time{}, "At").Value()
+
+		require.NoError(t, fs.Parse([]string{"--at", "2024-01-01 12:00:00"}))
+		assert.True(t, at.Equal(time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("relative times", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			arg  string
+			want time.Time
+		}{
+			{arg: "now", want: fixedNow},
+			{arg: "now-2h", want: fixedNow.Add(-2 * time.Hour)},
+			{arg: "now+1h30m", want: fixedNow.Add(90 * time.Minute)},
+			{arg: "-30m", want: fixedNow.Add(-30 * time.Minute)},
+			{arg: "+15s", want: fixedNow.Add(15 * time.Second)},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.arg, func(t *testing.T) {
+				t.Parallel()
+
+				fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+				fs.SetNowFn(func() time.Time { return fixedNow })
+				at := fs.Time("at", time.Time{}, "At").Value()
+
+				require.NoError(t, fs.Parse([]string{"--at=" + tt.arg}))
+				assert.Equal(t, tt.want, *at)
+			})
+		}
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.Time("at", time.Time{}, "At")
+		err := fs.Parse([]string{"--at=now-2x"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid relative time "now-2x"`)
+	})
+
+	t.Run("time ranges", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.SetNowFn(func() time.Time { return fixedNow })
+		window := fs.TimeRange("window", tinyflags.TimeRange{}, "Window").Value()
+		windows := fs.TimeRangeSlice("windows", nil, "Windows").Value()
+
+		require.NoError(t, fs.Parse([]string{
+			"--window", "now-24h..now",
+			"--windows", "2024-01-01T00:00:00Z..2024-01-02T00:00:00Z",
+		}))
+		assert.Equal(t, tinyflags.TimeRange{Start: fixedNow.Add(-24 * time.Hour), End: fixedNow}, *window)
+		assert.True(t, window.Contains(fixedNow.Add(-time.Hour)))
+		assert.Equal(t, 24*time.Hour, window.Duration())
+		require.Len(t, *windows, 1)
+		assert.Equal(t, 24*time.Hour, (*windows)[0].Duration())
+
+		err := fs.Parse([]string{"--window", "now..now-1h"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "end is before start")
+
+		err = fs.Parse([]string{"--window", "now"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid time range "now": expected start..end`)
+	})
+
+	t.Run("locations", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		tz := fs.Location("tz", time.UTC, "Time zone").Value()
+		zones := fs.LocationSlice("zones", nil, "Zones").Value()
+
+		require.NoError(t, fs.Parse([]string{"--tz", "America/New_York", "--zones", "UTC,Asia/Tokyo"}))
+		assert.Equal(t, "America/New_York", (*tz).String())
+		require.Len(t, *zones, 2)
+		assert.Equal(t, "Asia/Tokyo", (*zones)[1].String())
+
+		err := fs.Parse([]string{"--tz", "Mars/Olympus"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown time zone "Mars/Olympus"`)
+	})
+
+	t.Run("help uses first layout", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.SetTimeLayouts(time.DateOnly, time.RFC3339)
+		fs.Time("since", time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC), "Since")
+		fs.TimeRange("window", tinyflags.TimeRange{
+			Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		}, "Window")
+		fs.Location("tz", time.UTC, "Time zone")
+
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "(default: 2024-02-03)")
+		assert.Contains(t, err.Error(), "(default: 2024-01-01..2024-01-31)")
+		assert.Contains(t, err.Error(), "(default: UTC)")
+	})
+
+	t.Run("child commands inherit settings", func(t *testing.T) {
+		t.Parallel()
+
+		root := tinyflags.NewCommand("app", tinyflags.ContinueOnError)
+		root.SetTimeLayouts(time.DateOnly)
+		root.SetTimeLocation(time.FixedZone("CET", 3600))
+		root.SetNowFn(func() time.Time { return fixedNow })
+		report := root.Command("report", "Report")
+		since := report.Time("since", time.Time{}, "Since").Value()
+		until := report.DynamicGroup("job").Time("until", time.Time{}, "Until")
+
+		require.NoError(t, root.Parse([]string{"report", "--since=2024-02-03", "--job.a.until=now"}))
+		assert.Equal(t, time.Date(2024, 2, 3, 0, 0, 0, 0, time.FixedZone("CET", 3600)).Unix(), since.Unix())
+		assert.Equal(t, fixedNow, until.MustGet("a"))
+
+		report.SetTimeLayouts(time.RFC3339)
+		require.NoError(t, root.Parse([]string{"report", "--since=2024-02-03T10:00:00Z"}))
+		assert.Equal(t, time.Date(2024, 2, 3, 10, 0, 0, 0, time.UTC), *since)
+	})
+
+	t.Run("dynamic group", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.SetNowFn(func() time.Time { return fixedNow })
+		fs.SetTimeLayouts(time.RFC3339, tinyflags.TimeLayoutUnix)
+		job := fs.DynamicGroup("job")
+		start := job.Time("start", time.Time{}, "Start")
+		window := job.TimeRange("window", tinyflags.TimeRange{}, "Window")
+		tz := job.Location("tz", time.UTC, "Time zone")
+		skips := job.TimeSlice("skip", nil, "Skip")
+
+		require.NoError(t, fs.Parse([]string{
+			"--job.a.start=now-1h",
+			"--job.a.window=-2h..now",
+			"--job.a.tz=Europe/Berlin",
+			"--job.a.skip=1700000000,now",
+		}))
+		assert.Equal(t, fixedNow.Add(-time.Hour), start.MustGet("a"))
+		assert.Equal(t, 2*time.Hour, window.MustGet("a").Duration())
+		assert.Equal(t, "Europe/Berlin", tz.MustGet("a").String())
+		assert.Equal(t, []time.Time{time.Unix(1700000000, 0).UTC(), fixedNow}, skips.MustGet("a"))
+	})
+
+	t.Run("bind and positionals", func(t *testing.T) {
+		t.Parallel()
+
+		type config struct {
+			Window tinyflags.TimeRange `flag:"window"`
+			TZ     *time.Location      `flag:"tz" default:"UTC"`
+		}
+		var cfg config
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.SetNowFn(func() time.Time { return fixedNow })
+		require.NoError(t, tinyflags.Bind(fs, &cfg))
+		at := tinyflags.Positional[time.Time](fs, "at", "At")
+
+		require.NoError(t, fs.Parse([]string{"--window=now-1h..now", "now-5m"}))
+		assert.Equal(t, time.Hour, cfg.Window.Duration())
+		assert.Equal(t, time.UTC, cfg.TZ)
+		assert.Equal(t, fixedNow.Add(-5*time.Minute), *at.Value())
+	})
+}
@@ -0,0 +1,126 @@
+package tinyflags_test
+
+import (
+	"testing"
+
+	"github.com/containeroo/tinyflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnsignedFlags verifies uint scalar, slice and dynamic flags.
+func TestUnsignedFlags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("base prefixes and separators", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			arg  string
+			want uint64
+		}{
+			{name: "decimal", arg: "42", want: 42},
+			{name: "hex", arg: "0xff", want: 255},
+			{name: "octal", arg: "0o17", want: 15},
+			{name: "binary", arg: "0b101", want: 5},
+			{name: "underscores", arg: "1_000_000", want: 1000000},
+			{name: "zero padded is decimal", arg: "010", want: 10},
+			{name: "zero", arg: "000", want: 0},
+			{name: "zero padded with separators", arg: "000_001", want: 1},
+			{name: "zero and separator", arg: "0_10", want: 10},
+			{name: "max", arg: "18446744073709551615", want: 18446744073709551615},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+				v := fs.Uint64("size", 0, "Size").Value()
+				require.NoError(t, fs.Parse([]string{"--size=" + tt.arg}))
+				assert.Equal(t, tt.want, *v)
+			})
+		}
+	})
+
+	t.Run("every width", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		u := fs.Uint("u", 1, "uint").Value()
+		u8 := fs.Uint8("u8", 0, "uint8").Value()
+		u16 := fs.Uint16("u16", 0, "uint16").Value()
+		u32 := fs.Uint32("u32", 0, "uint32").Value()
+
+		require.NoError(t, fs.Parse([]string{"--u8=255", "--u16=0xffff", "--u32=4294967295"}))
+		assert.Equal(t, uint(1), *u)
+		assert.Equal(t, uint8(255), *u8)
+		assert.Equal(t, uint16(65535), *u16)
+		assert.Equal(t, uint32(4294967295), *u32)
+	})
+
+	t.Run("overflow and negatives are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.Uint8("u8", 0, "uint8")
+		fs.Uint16("u16", 0, "uint16")
+
+		err := fs.Parse([]string{"--u8=256"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "value out of range")
+
+		err = fs.Parse([]string{"--u16=-1"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid syntax")
+	})
+
+	t.Run("slices", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		ports := fs.Uint16Slice("port", []uint16{80}, "Ports").Value()
+		masks := fs.Uint32Slice("mask", nil, "Masks").Value()
+
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, []uint16{80}, *ports)
+
+		require.NoError(t, fs.Parse([]string{"--port=443, 8443", "--mask=0xff00,0b1"}))
+		assert.Equal(t, []uint16{443, 8443}, *ports)
+		assert.Equal(t, []uint32{0xff00, 1}, *masks)
+
+		err := fs.Parse([]string{"--port=70000"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "value out of range")
+	})
+
+	t.Run("dynamic group", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		http := fs.DynamicGroup("http")
+		port := http.Uint16("port", 80, "Port")
+		weights := http.Uint8Slice("weight", nil, "Weights")
+
+		require.NoError(t, fs.Parse([]string{"--http.a.port=0x1f90", "--http.a.weight=1,2", "--http.b.weight=3"}))
+		p, _ := port.Get("a")
+		assert.Equal(t, uint16(8080), p)
+		p, _ = port.Get("b")
+		assert.Equal(t, uint16(80), p)
+		w, _ := weights.Get("a")
+		assert.Equal(t, []uint8{1, 2}, w)
+
+		require.Error(t, fs.Parse([]string{"--http.a.port=65536"}))
+	})
+
+	t.Run("help shows decimal defaults", func(t *testing.T) {
+		t.Parallel()
+
+		fs := tinyflags.NewFlagSet("app", tinyflags.ContinueOnError)
+		fs.Uint32("mode", 0o755, "File mode")
+		err := fs.Parse([]string{"--help"})
+		require.True(t, tinyflags.IsHelpRequested(err))
+		assert.Contains(t, err.Error(), "(default: 493)")
+	})
+}
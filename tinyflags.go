@@ -4,10 +4,14 @@ package tinyflags
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/containeroo/tinyflags/internal/core"
 	"github.com/containeroo/tinyflags/internal/dynamic"
 	"github.com/containeroo/tinyflags/internal/engine"
+	"github.com/containeroo/tinyflags/internal/suggest"
+	"github.com/containeroo/tinyflags/internal/utils"
 )
 
 // ErrorHandling defines how parsing errors are handled.
@@ -23,6 +27,7 @@ const (
 type (
 	HelpRequested    = engine.HelpRequested
 	VersionRequested = engine.VersionRequested
+	UnknownFlagError = engine.UnknownFlagError
 )
 
 // UsageError wraps a semantic parse error with rendered help text.
@@ -57,11 +62,36 @@ func (e *CommandRequired) Error() string {
 	return `command "` + e.Command + `" requires a subcommand`
 }
 
+// UnknownCommandError is returned when a bare argument looks like a mistyped child command.
+type UnknownCommandError struct {
+	Command     string   // Full path of the command the argument was given to
+	Name        string   // Argument as typed
+	Suggestions []string // Close child command names, closest first
+}
+
+// Error describes the unknown command and lists suggestions, if any.
+func (e *UnknownCommandError) Error() string {
+	quoted := make([]string, len(e.Suggestions))
+	for i, s := range e.Suggestions {
+		quoted[i] = strconv.Quote(s)
+	}
+	return fmt.Sprintf("unknown command %q for %q", e.Name, e.Command) + suggest.Hint(quoted)
+}
+
+// CompletionRequested is returned by Command.Parse after it answered a hidden __complete request.
+type CompletionRequested struct{}
+
+// Error reports that the parse was a completion request rather than a normal invocation.
+func (e *CompletionRequested) Error() string {
+	return "completion requested"
+}
+
 var (
 	IsHelpRequested    = engine.IsHelpRequested
 	IsVersionRequested = engine.IsVersionRequested
 	RequestHelp        = engine.RequestHelp
 	RequestVersion     = engine.RequestVersion
+	IsUnknownFlag      = engine.IsUnknownFlag
 )
 
 // IsCommandRequired checks whether err indicates a missing required subcommand.
@@ -70,6 +100,18 @@ func IsCommandRequired(err error) bool {
 	return errors.As(err, &target)
 }
 
+// IsUnknownCommand checks whether err indicates a mistyped or unknown child command.
+func IsUnknownCommand(err error) bool {
+	var target *UnknownCommandError
+	return errors.As(err, &target)
+}
+
+// IsCompletionRequested checks whether err indicates an answered completion request.
+func IsCompletionRequested(err error) bool {
+	var target *CompletionRequested
+	return errors.As(err, &target)
+}
+
 // HelpText extracts rendered help text from a usage-bearing error.
 func HelpText(err error) (string, bool) {
 	var usageErr *UsageError
@@ -90,11 +132,31 @@ const (
 	PrintBoth  = engine.PrintBoth  // Prints: -v|--verbose
 )
 
+// ByteUnits selects how Bytes flags render sizes in help, dumps and docs.
+type ByteUnits = utils.ByteUnits
+
+const (
+	ByteUnitsAuto  = utils.ByteUnitsAuto  // Shortest exact form: 10MiB, 1.5GB
+	ByteUnitsIEC   = utils.ByteUnitsIEC   // Powers of 1024: KiB, MiB, GiB
+	ByteUnitsSI    = utils.ByteUnitsSI    // Powers of 1000: KB, MB, GB
+	ByteUnitsPlain = utils.ByteUnitsPlain // Plain byte count: 10485760
+)
+
+// TimeRange is an inclusive span parsed from "start..end" by TimeRange flags.
+type TimeRange = utils.TimeRange
+
+// Pseudo layouts for SetTimeLayouts that parse Unix epoch timestamps.
+const (
+	TimeLayoutUnix      = utils.TimeLayoutUnix      // Seconds since the epoch: 1700000000
+	TimeLayoutUnixMilli = utils.TimeLayoutUnixMilli // Milliseconds since the epoch: 1700000000000
+)
+
 // Exported types for advanced access.
 type (
-	DynamicGroup = dynamic.Group // Dynamic group of instance-scoped flags
-	StaticFlag   = core.BaseFlag // Static flag definition metadata
-	Flag[T any]  = core.Flag[T]  // Minimal flag handle interface
+	DynamicGroup         = dynamic.Group       // Dynamic group of instance-scoped flags
+	GroupInstance[T any] = dynamic.Instance[T] // Decoded instance of a dynamic group
+	StaticFlag           = core.BaseFlag       // Static flag definition metadata
+	Flag[T any]          = core.Flag[T]        // Minimal flag handle interface
 )
 
 // FlagSet is the user-facing flag parser and usage configurator.